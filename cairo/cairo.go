@@ -22,10 +22,12 @@ package cairo
 // #include <stdlib.h>
 // #include <cairo.h>
 // #include <cairo-gobject.h>
+// #include "cairo.go.h"
 import "C"
 import (
 	"reflect"
 	"runtime"
+	"sync"
 	"unsafe"
 
 	"github.com/conformal/gotk3/glib"
@@ -37,6 +39,7 @@ func init() {
 		{glib.Type(C.cairo_gobject_antialias_get_type()), marshalAntialias},
 		{glib.Type(C.cairo_gobject_content_get_type()), marshalContent},
 		{glib.Type(C.cairo_gobject_fill_rule_get_type()), marshalFillRule},
+		{glib.Type(C.cairo_gobject_format_get_type()), marshalFormat},
 		{glib.Type(C.cairo_gobject_line_cap_get_type()), marshalLineCap},
 		{glib.Type(C.cairo_gobject_line_join_get_type()), marshalLineJoin},
 		{glib.Type(C.cairo_gobject_operator_get_type()), marshalOperator},
@@ -66,6 +69,53 @@ func gobool(b C.cairo_bool_t) bool {
 	return false
 }
 
+// statusError returns status as an error, or nil if status is
+// CAIRO_STATUS_SUCCESS.
+func statusError(status C.cairo_status_t) error {
+	return Status(status).ToError()
+}
+
+// Go handles
+
+var handles = struct {
+	sync.RWMutex
+	m map[unsafe.Pointer]interface{}
+}{
+	m: make(map[unsafe.Pointer]interface{}),
+}
+
+// newHandle saves a Go value which must stay reachable for as long as
+// Cairo holds on to it, such as the pixel data of a surface.  The
+// returned pointer is handed to Cairo as user data and must be released
+// with freeHandle, either directly or by passing freeHandle as the
+// cairo_destroy_func_t.
+func newHandle(v interface{}) unsafe.Pointer {
+	// Allocate a byte of C memory so each handle has a unique pointer
+	// that is safe to hand to C code.
+	p := C.malloc(1)
+	handles.Lock()
+	handles.m[p] = v
+	handles.Unlock()
+	return p
+}
+
+// getHandle returns the Go value saved by newHandle.
+func getHandle(p unsafe.Pointer) interface{} {
+	handles.RLock()
+	defer handles.RUnlock()
+	return handles.m[p]
+}
+
+// freeHandle removes a Go value saved by newHandle.
+//
+//export freeHandle
+func freeHandle(p unsafe.Pointer) {
+	handles.Lock()
+	delete(handles.m, p)
+	handles.Unlock()
+	C.free(p)
+}
+
 // Constants
 
 // Antialias is a representation of Cairo's cairo_antialias_t.
@@ -113,6 +163,24 @@ func marshalFillRule(p uintptr) (interface{}, error) {
 	return FillRule(c), nil
 }
 
+// Format is a representation of Cairo's cairo_format_t.
+type Format int
+
+const (
+	FORMAT_INVALID   Format = C.CAIRO_FORMAT_INVALID
+	FORMAT_ARGB32    Format = C.CAIRO_FORMAT_ARGB32
+	FORMAT_RGB24     Format = C.CAIRO_FORMAT_RGB24
+	FORMAT_A8        Format = C.CAIRO_FORMAT_A8
+	FORMAT_A1        Format = C.CAIRO_FORMAT_A1
+	FORMAT_RGB16_565 Format = C.CAIRO_FORMAT_RGB16_565
+	// FORMAT_RGB30     Format = C.CAIRO_FORMAT_RGB30 (since 1.12)
+)
+
+func marshalFormat(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return Format(c), nil
+}
+
 // LineCap is a representation of Cairo's cairo_line_cap_t.
 type LineCap int
 
@@ -242,6 +310,23 @@ func marshalStatus(p uintptr) (interface{}, error) {
 	return Status(c), nil
 }
 
+// Error is a wrapper around cairo_status_to_string().  It allows a Status
+// to be used as a Go error, and errors returned by this package are of
+// type Status unless they come from a Go reader or writer.
+func (v Status) Error() string {
+	c := C.cairo_status_to_string(C.cairo_status_t(v))
+	return C.GoString(c)
+}
+
+// ToError returns nil if the status is STATUS_SUCCESS, or else the status
+// itself as an error.
+func (v Status) ToError() error {
+	if v == STATUS_SUCCESS {
+		return nil
+	}
+	return v
+}
+
 // SurfaceType is a representation of Cairo's cairo_surface_type_t.
 type SurfaceType int
 
@@ -310,12 +395,17 @@ func wrapContext(context *C.cairo_t) *Context {
 	return &Context{context}
 }
 
-// Create is a wrapper around cairo_create().
-func Create(target *Surface) *Context {
+// Create is a wrapper around cairo_create().  An error is returned if
+// the context could not be created, such as when target is in an error
+// state.
+func Create(target *Surface) (*Context, error) {
 	c := C.cairo_create(target.native())
 	ctx := wrapContext(c)
 	runtime.SetFinalizer(ctx, (*Context).destroy)
-	return ctx
+	if err := statusError(C.cairo_status(c)); err != nil {
+		return nil, err
+	}
+	return ctx, nil
 }
 
 // reference is a wrapper around cairo_reference().
@@ -334,6 +424,14 @@ func (v *Context) Status() Status {
 	return Status(c)
 }
 
+// Err returns the error of the context, or nil if it is not in an error
+// state.  Once a context is in an error state, all further drawing with
+// it is ignored, so Err may be checked once after a sequence of drawing
+// operations.
+func (v *Context) Err() error {
+	return v.Status().ToError()
+}
+
 // Save is a wrapper around cairo_save().
 func (v *Context) Save() {
 	C.cairo_save(v.native())
@@ -363,7 +461,11 @@ func (v *Context) PushGroupWithContent(content Content) {
 	C.cairo_push_group_with_content(v.native(), C.cairo_content_t(content))
 }
 
-// TODO(jrick) PopGroup (depends on Pattern)
+// PopGroup is a wrapper around cairo_pop_group().
+func (v *Context) PopGroup() *Pattern {
+	c := C.cairo_pop_group(v.native())
+	return takePattern(c)
+}
 
 // PopGroupToSource is a wrapper around cairo_pop_group_to_source().
 func (v *Context) PopGroupToSource() {
@@ -391,7 +493,10 @@ func (v *Context) SetSourceRGBA(red, green, blue, alpha float64) {
 		C.double(blue), C.double(alpha))
 }
 
-// TODO(jrick) SetSource (depends on Pattern)
+// SetSource is a wrapper around cairo_set_source().
+func (v *Context) SetSource(source *Pattern) {
+	C.cairo_set_source(v.native(), source.native())
+}
 
 // SetSourceSurface is a wrapper around cairo_set_source_surface().
 func (v *Context) SetSourceSurface(surface *Surface, x, y float64) {
@@ -399,7 +504,11 @@ func (v *Context) SetSourceSurface(surface *Surface, x, y float64) {
 		C.double(y))
 }
 
-// TODO(jrick) GetSource (depends on Pattern)
+// GetSource is a wrapper around cairo_get_source().
+func (v *Context) GetSource() *Pattern {
+	c := C.cairo_get_source(v.native())
+	return refPattern(c)
+}
 
 // SetAntialias is a wrapper around cairo_set_antialias().
 func (v *Context) SetAntialias(antialias Antialias) {
@@ -615,7 +724,10 @@ func (v *Context) InFill(x, y float64) bool {
 	return gobool(c)
 }
 
-// TODO(jrick) Mask (depends on Pattern)
+// Mask is a wrapper around cairo_mask().
+func (v *Context) Mask(pattern *Pattern) {
+	C.cairo_mask(v.native(), pattern.native())
+}
 
 // MaskSurface is a wrapper around cairo_mask_surface().
 func (v *Context) MaskSurface(surface *Surface, surfaceX, surfaceY float64) {
@@ -666,6 +778,71 @@ func (v *Context) ShowPage() {
 	C.cairo_show_page(v.native())
 }
 
+// Translate is a wrapper around cairo_translate().
+func (v *Context) Translate(tx, ty float64) {
+	C.cairo_translate(v.native(), C.double(tx), C.double(ty))
+}
+
+// Scale is a wrapper around cairo_scale().
+func (v *Context) Scale(sx, sy float64) {
+	C.cairo_scale(v.native(), C.double(sx), C.double(sy))
+}
+
+// Rotate is a wrapper around cairo_rotate().
+func (v *Context) Rotate(angle float64) {
+	C.cairo_rotate(v.native(), C.double(angle))
+}
+
+// Transform is a wrapper around cairo_transform().
+func (v *Context) Transform(matrix *Matrix) {
+	C.cairo_transform(v.native(), matrix.native())
+}
+
+// SetMatrix is a wrapper around cairo_set_matrix().
+func (v *Context) SetMatrix(matrix *Matrix) {
+	C.cairo_set_matrix(v.native(), matrix.native())
+}
+
+// GetMatrix is a wrapper around cairo_get_matrix().
+func (v *Context) GetMatrix() *Matrix {
+	var matrix Matrix
+	C.cairo_get_matrix(v.native(), matrix.native())
+	return &matrix
+}
+
+// IdentityMatrix is a wrapper around cairo_identity_matrix().
+func (v *Context) IdentityMatrix() {
+	C.cairo_identity_matrix(v.native())
+}
+
+// UserToDevice is a wrapper around cairo_user_to_device().
+func (v *Context) UserToDevice(x, y float64) (float64, float64) {
+	cx, cy := C.double(x), C.double(y)
+	C.cairo_user_to_device(v.native(), &cx, &cy)
+	return float64(cx), float64(cy)
+}
+
+// UserToDeviceDistance is a wrapper around cairo_user_to_device_distance().
+func (v *Context) UserToDeviceDistance(dx, dy float64) (float64, float64) {
+	cdx, cdy := C.double(dx), C.double(dy)
+	C.cairo_user_to_device_distance(v.native(), &cdx, &cdy)
+	return float64(cdx), float64(cdy)
+}
+
+// DeviceToUser is a wrapper around cairo_device_to_user().
+func (v *Context) DeviceToUser(x, y float64) (float64, float64) {
+	cx, cy := C.double(x), C.double(y)
+	C.cairo_device_to_user(v.native(), &cx, &cy)
+	return float64(cx), float64(cy)
+}
+
+// DeviceToUserDistance is a wrapper around cairo_device_to_user_distance().
+func (v *Context) DeviceToUserDistance(dx, dy float64) (float64, float64) {
+	cdx, cdy := C.double(dx), C.double(dy)
+	C.cairo_device_to_user_distance(v.native(), &cdx, &cdy)
+	return float64(cdx), float64(cdy)
+}
+
 // TODO(jrick) SetUserData (depends on UserDataKey and DestroyFunc)
 
 // TODO(jrick) GetUserData (depends on UserDataKey)
@@ -715,24 +892,31 @@ func NewSurface(s uintptr, needsRef bool) *Surface {
 	return surface
 }
 
+// takeSurface wraps a newly-created surface, returning an error if it is
+// in an error state.
+func takeSurface(c *C.cairo_surface_t) (*Surface, error) {
+	s := wrapSurface(c)
+	runtime.SetFinalizer(s, (*Surface).destroy)
+	if err := statusError(C.cairo_surface_status(c)); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
 // CreateSimilar is a wrapper around cairo_surface_create_similar().
-func (v *Surface) CreateSimilar(content Content, width, height int) *Surface {
+func (v *Surface) CreateSimilar(content Content, width, height int) (*Surface, error) {
 	c := C.cairo_surface_create_similar(v.native(),
 		C.cairo_content_t(content), C.int(width), C.int(height))
-	s := wrapSurface(c)
-	runtime.SetFinalizer(s, (*Surface).destroy)
-	return s
+	return takeSurface(c)
 }
 
 // TODO cairo_surface_create_similar_image (since 1.12)
 
 // CreateForRectangle is a wrapper around cairo_surface_create_for_rectangle().
-func (v *Surface) CreateForRectangle(x, y, width, height float64) *Surface {
+func (v *Surface) CreateForRectangle(x, y, width, height float64) (*Surface, error) {
 	c := C.cairo_surface_create_for_rectangle(v.native(), C.double(x),
 		C.double(y), C.double(width), C.double(height))
-	s := wrapSurface(c)
-	runtime.SetFinalizer(s, (*Surface).destroy)
-	return s
+	return takeSurface(c)
 }
 
 // reference is a wrapper around cairo_surface_reference().
@@ -756,9 +940,23 @@ func (v *Surface) Flush() {
 	C.cairo_surface_flush(v.native())
 }
 
-// TODO(jrick) GetDevice (requires Device bindings)
+// Err returns the error of the surface, or nil if it is not in an error
+// state.
+func (v *Surface) Err() error {
+	return v.Status().ToError()
+}
 
-// TODO(jrick) GetFontOptions (require FontOptions bindings)
+// Finish is a wrapper around cairo_surface_finish().  Once Finish
+// returns, all output of a surface writing to a file or io.Writer has
+// been written, and any error writing it is returned.
+func (v *Surface) Finish() error {
+	C.cairo_surface_finish(v.native())
+	p := C._cairo_surface_get_go_data(v.native())
+	if s, ok := getHandle(p).(*stream); ok && s.err != nil {
+		return s.err
+	}
+	return statusError(C.cairo_surface_status(v.native()))
+}
 
 // TODO(jrick) GetContent (requires Content bindings)
 
@@ -826,7 +1024,37 @@ func (v *Surface) HasShowTextGlyphs() bool {
 	return gobool(c)
 }
 
-// TODO(jrick) SetMimeData (depends on DestroyFunc)
+// SetMimeData is a wrapper around cairo_surface_set_mime_data().  It
+// attaches data in the encoded form given by mimeType, such as the
+// original JPEG file of an image surface, so that surfaces supporting
+// the type (such as PDF) may emit it instead of re-encoding the pixels.
+// data is not copied and must not be modified afterwards; it is kept
+// alive until Cairo releases it.  A nil or empty slice removes any data
+// attached for mimeType.
+func (v *Surface) SetMimeData(mimeType MimeType, data []byte) error {
+	if err := v.Err(); err != nil {
+		return err
+	}
+	cstr := C.CString(string(mimeType))
+	defer C.free(unsafe.Pointer(cstr))
+	var p *C.uchar
+	var h unsafe.Pointer
+	if len(data) > 0 {
+		p = (*C.uchar)(unsafe.Pointer(&data[0]))
+		h = newHandle(data)
+	}
+	// Once the data is attached, Cairo calls freeHandle when it is
+	// replaced or the surface is destroyed.
+	c := C._cairo_surface_set_mime_data(v.native(), cstr, p,
+		C.ulong(len(data)), h)
+	if err := statusError(c); err != nil {
+		if h != nil {
+			freeHandle(h)
+		}
+		return err
+	}
+	return nil
+}
 
 // GetMimeData is a wrapper around cairo_surface_get_mime_data().  The
 // returned mimetype data is returned as a Go byte slice.
@@ -839,8 +1067,6 @@ func (v *Surface) GetMimeData(mimeType MimeType) []byte {
 	return C.GoBytes(unsafe.Pointer(data), C.int(length))
 }
 
-// TODO(jrick) SupportsMimeType (since 1.12)
-
 // TODO(jrick) MapToImage (since 1.12)
 
 // TODO(jrick) UnmapImage (since 1.12)
@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+ *
+ * This file originated from: http://opensource.conformal.com/
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+#ifndef __CAIRO_GO_H__
+#define __CAIRO_GO_H__
+
+#include <stdlib.h>
+
+extern void freeHandle(void *);
+extern cairo_status_t goWriteFunc(void *, unsigned char *, unsigned int);
+extern cairo_status_t goReadFunc(void *, unsigned char *, unsigned int);
+
+/*
+ * Go data kept alive by surfaces and devices
+ */
+
+/*
+ * goDataKey is defined once in go_data.c.  A static key would give each
+ * cgo file its own copy, and data set from one file could not be found
+ * from another.
+ */
+extern cairo_user_data_key_t goDataKey;
+
+static cairo_status_t
+_cairo_surface_set_go_data(cairo_surface_t *surface, void *handle)
+{
+	return (cairo_surface_set_user_data(surface, &goDataKey, handle,
+	    freeHandle));
+}
+
+static void *
+_cairo_surface_get_go_data(cairo_surface_t *surface)
+{
+	return (cairo_surface_get_user_data(surface, &goDataKey));
+}
+
+static cairo_status_t
+_cairo_device_set_go_data(cairo_device_t *device, void *handle)
+{
+	return (cairo_device_set_user_data(device, &goDataKey, handle,
+	    freeHandle));
+}
+
+static void *
+_cairo_device_get_go_data(cairo_device_t *device)
+{
+	return (cairo_device_get_user_data(device, &goDataKey));
+}
+
+static cairo_status_t
+_cairo_surface_set_mime_data(cairo_surface_t *surface, const char *mime_type,
+    unsigned char *data, unsigned long length, void *handle)
+{
+	return (cairo_surface_set_mime_data(surface, mime_type, data, length,
+	    freeHandle, handle));
+}
+
+/*
+ * PNG streams
+ */
+
+static cairo_status_t
+_cairo_surface_write_to_png_stream(cairo_surface_t *surface, void *closure)
+{
+	return (cairo_surface_write_to_png_stream(surface,
+	    (cairo_write_func_t)goWriteFunc, closure));
+}
+
+static cairo_surface_t *
+_cairo_image_surface_create_from_png_stream(void *closure)
+{
+	return (cairo_image_surface_create_from_png_stream(
+	    (cairo_read_func_t)goReadFunc, closure));
+}
+
+#endif
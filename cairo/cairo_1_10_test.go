@@ -0,0 +1,14 @@
+// +build cairo_1_10
+
+package cairo_test
+
+import (
+	"testing"
+
+	"github.com/conformal/gotk3/cairo"
+)
+
+// checkSupportsMimeType does nothing, as cairo_surface_supports_mime_type()
+// requires Cairo 1.12.
+func checkSupportsMimeType(t *testing.T, pdf, img *cairo.Surface) {
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+// This file includes wrapers for symbols included since Cairo 1.12, and
+// and should not be included in a build intended to target any older Cairo
+// versions.  To target an older build, such as 1.10, use
+// 'go build -tags cairo_1_10'.  Otherwise, if no build tags are used, Cairo
+// 1.16 is assumed and this file is built.
+// +build !cairo_1_10
+
+package cairo
+
+// #cgo pkg-config: cairo cairo-gobject
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include <cairo-gobject.h>
+import "C"
+import (
+	"unsafe"
+)
+
+const (
+	PATTERN_TYPE_MESH          PatternType = C.CAIRO_PATTERN_TYPE_MESH
+	PATTERN_TYPE_RASTER_SOURCE PatternType = C.CAIRO_PATTERN_TYPE_RASTER_SOURCE
+)
+
+/*
+ * Mesh patterns
+ */
+
+// MeshPattern is a representation of a Cairo mesh pattern, a gradient
+// made of Coons patches and tensor-product patches.  Each patch is
+// defined by a path of up to four sides between BeginPatch and EndPatch,
+// and a color for each corner.
+type MeshPattern struct {
+	*Pattern
+}
+
+// PatternCreateMesh is a wrapper around cairo_pattern_create_mesh().
+func PatternCreateMesh() *MeshPattern {
+	c := C.cairo_pattern_create_mesh()
+	return &MeshPattern{takePattern(c)}
+}
+
+// BeginPatch is a wrapper around cairo_mesh_pattern_begin_patch().
+func (v *MeshPattern) BeginPatch() {
+	C.cairo_mesh_pattern_begin_patch(v.native())
+}
+
+// EndPatch is a wrapper around cairo_mesh_pattern_end_patch().
+func (v *MeshPattern) EndPatch() {
+	C.cairo_mesh_pattern_end_patch(v.native())
+}
+
+// MoveTo is a wrapper around cairo_mesh_pattern_move_to().
+func (v *MeshPattern) MoveTo(x, y float64) {
+	C.cairo_mesh_pattern_move_to(v.native(), C.double(x), C.double(y))
+}
+
+// LineTo is a wrapper around cairo_mesh_pattern_line_to().
+func (v *MeshPattern) LineTo(x, y float64) {
+	C.cairo_mesh_pattern_line_to(v.native(), C.double(x), C.double(y))
+}
+
+// CurveTo is a wrapper around cairo_mesh_pattern_curve_to().
+func (v *MeshPattern) CurveTo(x1, y1, x2, y2, x3, y3 float64) {
+	C.cairo_mesh_pattern_curve_to(v.native(), C.double(x1), C.double(y1),
+		C.double(x2), C.double(y2), C.double(x3), C.double(y3))
+}
+
+// SetControlPoint is a wrapper around
+// cairo_mesh_pattern_set_control_point().
+func (v *MeshPattern) SetControlPoint(pointNum uint, x, y float64) {
+	C.cairo_mesh_pattern_set_control_point(v.native(), C.uint(pointNum),
+		C.double(x), C.double(y))
+}
+
+// SetCornerColorRGB is a wrapper around
+// cairo_mesh_pattern_set_corner_color_rgb().
+func (v *MeshPattern) SetCornerColorRGB(cornerNum uint, red, green, blue float64) {
+	C.cairo_mesh_pattern_set_corner_color_rgb(v.native(),
+		C.uint(cornerNum), C.double(red), C.double(green),
+		C.double(blue))
+}
+
+// SetCornerColorRGBA is a wrapper around
+// cairo_mesh_pattern_set_corner_color_rgba().
+func (v *MeshPattern) SetCornerColorRGBA(cornerNum uint, red, green, blue, alpha float64) {
+	C.cairo_mesh_pattern_set_corner_color_rgba(v.native(),
+		C.uint(cornerNum), C.double(red), C.double(green),
+		C.double(blue), C.double(alpha))
+}
+
+// GetPatchCount is a wrapper around cairo_mesh_pattern_get_patch_count().
+func (v *MeshPattern) GetPatchCount() (uint, error) {
+	var count C.uint
+	c := C.cairo_mesh_pattern_get_patch_count(v.native(), &count)
+	return uint(count), statusError(c)
+}
+
+// GetControlPoint is a wrapper around
+// cairo_mesh_pattern_get_control_point().
+func (v *MeshPattern) GetControlPoint(patchNum, pointNum uint) (x, y float64, err error) {
+	var cx, cy C.double
+	c := C.cairo_mesh_pattern_get_control_point(v.native(),
+		C.uint(patchNum), C.uint(pointNum), &cx, &cy)
+	return float64(cx), float64(cy), statusError(c)
+}
+
+// GetCornerColorRGBA is a wrapper around
+// cairo_mesh_pattern_get_corner_color_rgba().
+func (v *MeshPattern) GetCornerColorRGBA(patchNum, cornerNum uint) (red, green, blue, alpha float64, err error) {
+	var r, g, b, a C.double
+	c := C.cairo_mesh_pattern_get_corner_color_rgba(v.native(),
+		C.uint(patchNum), C.uint(cornerNum), &r, &g, &b, &a)
+	return float64(r), float64(g), float64(b), float64(a), statusError(c)
+}
+
+// GetExtents is a wrapper around cairo_recording_surface_get_extents().
+// ok is false if the recording surface is unbounded.
+func (v *RecordingSurface) GetExtents() (extents Rectangle, ok bool) {
+	var rect C.cairo_rectangle_t
+	c := C.cairo_recording_surface_get_extents(v.native(), &rect)
+	extents = Rectangle{float64(rect.x), float64(rect.y), float64(rect.width),
+		float64(rect.height)}
+	return extents, gobool(c)
+}
+
+// SupportsMimeType is a wrapper around cairo_surface_supports_mime_type().
+func (v *Surface) SupportsMimeType(mimeType MimeType) bool {
+	cstr := C.CString(string(mimeType))
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.cairo_surface_supports_mime_type(v.native(), cstr)
+	return gobool(c)
+}
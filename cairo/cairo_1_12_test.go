@@ -0,0 +1,81 @@
+// +build !cairo_1_10
+
+package cairo_test
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/conformal/gotk3/cairo"
+)
+
+func TestMeshPattern(t *testing.T) {
+	mesh := cairo.PatternCreateMesh()
+	if mesh.GetType() != cairo.PATTERN_TYPE_MESH {
+		t.Errorf("Pattern type is %v", mesh.GetType())
+	}
+	mesh.BeginPatch()
+	mesh.MoveTo(0, 0)
+	mesh.LineTo(32, 0)
+	mesh.LineTo(32, 32)
+	mesh.LineTo(0, 32)
+	mesh.SetCornerColorRGB(0, 1, 0, 0)
+	mesh.SetCornerColorRGB(1, 0, 1, 0)
+	mesh.SetCornerColorRGB(2, 0, 0, 1)
+	mesh.SetCornerColorRGBA(3, 1, 1, 1, 1)
+	mesh.EndPatch()
+
+	if n, err := mesh.GetPatchCount(); err != nil || n != 1 {
+		t.Errorf("GetPatchCount returned %d, %v", n, err)
+	}
+	if r, g, b, a, err := mesh.GetCornerColorRGBA(0, 1); err != nil || r != 0 || g != 1 || b != 0 || a != 1 {
+		t.Errorf("GetCornerColorRGBA returned %v %v %v %v, %v", r, g, b, a, err)
+	}
+	if _, _, err := mesh.GetControlPoint(1, 0); err == nil {
+		t.Error("Expected error for missing patch")
+	}
+
+	s := newImageSurface(t, 32, 32)
+	ctx := newContext(t, s.Surface)
+	ctx.SetSource(mesh.Pattern)
+	ctx.Paint()
+	img, err := s.RGBA()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Pixels are sampled at their centers, so corner pixels are close to,
+	// but not exactly, the corner colors.
+	near := func(a, b uint8) bool {
+		d := int(a) - int(b)
+		return d > -0x20 && d < 0x20
+	}
+	corners := []struct {
+		x, y int
+		c    color.RGBA
+	}{
+		{0, 0, color.RGBA{0xff, 0, 0, 0xff}},
+		{31, 0, color.RGBA{0, 0xff, 0, 0xff}},
+		{31, 31, color.RGBA{0, 0, 0xff, 0xff}},
+		{0, 31, color.RGBA{0xff, 0xff, 0xff, 0xff}},
+	}
+	for _, corner := range corners {
+		c := img.RGBAAt(corner.x, corner.y)
+		if !near(c.R, corner.c.R) || !near(c.G, corner.c.G) ||
+			!near(c.B, corner.c.B) || c.A != 0xff {
+			t.Errorf("Pixel at %d,%d is %v, expected about %v",
+				corner.x, corner.y, c, corner.c)
+		}
+	}
+}
+
+// checkSupportsMimeType checks that JPEG data attached to images is used
+// by PDF surfaces but not by image surfaces.
+func checkSupportsMimeType(t *testing.T, pdf, img *cairo.Surface) {
+	if !pdf.SupportsMimeType(cairo.MIME_TYPE_JPEG) {
+		t.Error("PDF surface does not support JPEG data")
+	}
+	if img.SupportsMimeType(cairo.MIME_TYPE_JPEG) {
+		t.Error("Image surface supports JPEG data")
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+// This file includes wrapers for symbols included since Cairo 1.16, and
+// and should not be included in a build intended to target any older Cairo
+// versions.  To target an older build, such as 1.14, use
+// 'go build -tags cairo_1_14'.  Otherwise, if no build tags are used, Cairo
+// 1.16 is assumed and this file is built.
+// +build !cairo_1_10,!cairo_1_12,!cairo_1_14
+
+package cairo
+
+// #cgo pkg-config: cairo cairo-gobject cairo-pdf cairo-svg
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include <cairo-gobject.h>
+// #include <cairo-pdf.h>
+// #include <cairo-svg.h>
+import "C"
+import (
+	"unsafe"
+)
+
+// PDFMetadata is a representation of Cairo's cairo_pdf_metadata_t.
+type PDFMetadata int
+
+const (
+	PDF_METADATA_TITLE       PDFMetadata = C.CAIRO_PDF_METADATA_TITLE
+	PDF_METADATA_AUTHOR      PDFMetadata = C.CAIRO_PDF_METADATA_AUTHOR
+	PDF_METADATA_SUBJECT     PDFMetadata = C.CAIRO_PDF_METADATA_SUBJECT
+	PDF_METADATA_KEYWORDS    PDFMetadata = C.CAIRO_PDF_METADATA_KEYWORDS
+	PDF_METADATA_CREATOR     PDFMetadata = C.CAIRO_PDF_METADATA_CREATOR
+	PDF_METADATA_CREATE_DATE PDFMetadata = C.CAIRO_PDF_METADATA_CREATE_DATE
+	PDF_METADATA_MOD_DATE    PDFMetadata = C.CAIRO_PDF_METADATA_MOD_DATE
+)
+
+// SetMetadata is a wrapper around cairo_pdf_surface_set_metadata().
+// Dates must be given in ISO-8601 format, such as "2014-05-01T09:30:00Z".
+func (v *PDFSurface) SetMetadata(metadata PDFMetadata, value string) {
+	cstr := C.CString(value)
+	defer C.free(unsafe.Pointer(cstr))
+	C.cairo_pdf_surface_set_metadata(v.native(),
+		C.cairo_pdf_metadata_t(metadata), cstr)
+}
+
+// SetPageLabel is a wrapper around cairo_pdf_surface_set_page_label().
+func (v *PDFSurface) SetPageLabel(label string) {
+	cstr := C.CString(label)
+	defer C.free(unsafe.Pointer(cstr))
+	C.cairo_pdf_surface_set_page_label(v.native(), cstr)
+}
+
+// SVGUnit is a representation of Cairo's cairo_svg_unit_t.
+type SVGUnit int
+
+const (
+	SVG_UNIT_USER    SVGUnit = C.CAIRO_SVG_UNIT_USER
+	SVG_UNIT_EM      SVGUnit = C.CAIRO_SVG_UNIT_EM
+	SVG_UNIT_EX      SVGUnit = C.CAIRO_SVG_UNIT_EX
+	SVG_UNIT_PX      SVGUnit = C.CAIRO_SVG_UNIT_PX
+	SVG_UNIT_IN      SVGUnit = C.CAIRO_SVG_UNIT_IN
+	SVG_UNIT_CM      SVGUnit = C.CAIRO_SVG_UNIT_CM
+	SVG_UNIT_MM      SVGUnit = C.CAIRO_SVG_UNIT_MM
+	SVG_UNIT_PT      SVGUnit = C.CAIRO_SVG_UNIT_PT
+	SVG_UNIT_PC      SVGUnit = C.CAIRO_SVG_UNIT_PC
+	SVG_UNIT_PERCENT SVGUnit = C.CAIRO_SVG_UNIT_PERCENT
+)
+
+// SetDocumentUnit is a wrapper around
+// cairo_svg_surface_set_document_unit().  It sets the unit of the width
+// and height attributes of the SVG root element.
+func (v *SVGSurface) SetDocumentUnit(unit SVGUnit) {
+	C.cairo_svg_surface_set_document_unit(v.native(),
+		C.cairo_svg_unit_t(unit))
+}
+
+// GetDocumentUnit is a wrapper around
+// cairo_svg_surface_get_document_unit().
+func (v *SVGSurface) GetDocumentUnit() SVGUnit {
+	c := C.cairo_svg_surface_get_document_unit(v.native())
+	return SVGUnit(c)
+}
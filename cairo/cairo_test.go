@@ -0,0 +1,786 @@
+package cairo_test
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/conformal/gotk3/cairo"
+)
+
+func newImageSurface(t *testing.T, width, height int) *cairo.ImageSurface {
+	s, err := cairo.ImageSurfaceCreate(cairo.FORMAT_ARGB32, width, height)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func newContext(t *testing.T, target *cairo.Surface) *cairo.Context {
+	ctx, err := cairo.Create(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ctx
+}
+
+func TestImageSurfaceCreate(t *testing.T) {
+	s, err := cairo.ImageSurfaceCreate(cairo.FORMAT_ARGB32, 4, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.GetWidth() != 4 || s.GetHeight() != 3 || s.GetFormat() != cairo.FORMAT_ARGB32 {
+		t.Error("Unexpected surface size or format")
+	}
+	stride := cairo.FormatStrideForWidth(cairo.FORMAT_ARGB32, 4)
+	if s.GetStride() != stride || len(s.GetData()) != stride*3 {
+		t.Error("Unexpected stride or data length")
+	}
+
+	ctx, err := cairo.Create(s.Surface)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx.SetSourceRGB(1, 0, 0)
+	ctx.Rectangle(0, 0, 2, 3)
+	ctx.Fill()
+	if err := ctx.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	img, err := s.RGBA()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := img.RGBAAt(0, 0); c != (color.RGBA{0xff, 0, 0, 0xff}) {
+		t.Errorf("Filled pixel is %v", c)
+	}
+	if c := img.RGBAAt(3, 2); c != (color.RGBA{}) {
+		t.Errorf("Cleared pixel is %v", c)
+	}
+}
+
+func TestImageSurfaceCreateForData(t *testing.T) {
+	stride := cairo.FormatStrideForWidth(cairo.FORMAT_A8, 5)
+	data := make([]byte, stride*2)
+	s, err := cairo.ImageSurfaceCreateForData(data, cairo.FORMAT_A8, 5, 2, stride)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := newContext(t, s.Surface)
+	ctx.Paint()
+	s.Flush()
+	for i := 0; i < 5; i++ {
+		if data[stride+i] != 0xff {
+			t.Fatalf("Paint did not draw into Go data: %v", data)
+		}
+	}
+
+	if _, err := cairo.ImageSurfaceCreateForData(data[:stride], cairo.FORMAT_A8, 5, 2, stride); err == nil {
+		t.Error("Expected error for short data")
+	}
+	if _, err := cairo.ImageSurfaceCreateForData(data, cairo.FORMAT_A8, 5, 2, 3); err == nil {
+		t.Error("Expected error for bad stride")
+	}
+}
+
+func TestImageSurfaceImage(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(10, 10, 12, 11))
+	src.SetNRGBA(10, 10, color.NRGBA{0x20, 0x40, 0x80, 0xff})
+	src.SetNRGBA(11, 10, color.NRGBA{0xff, 0x80, 0x00, 0x80})
+
+	s, err := cairo.ImageSurfaceCreateFromImage(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.GetWidth() != 2 || s.GetHeight() != 1 {
+		t.Fatal("Unexpected surface size")
+	}
+
+	rgba, err := s.RGBA()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := rgba.RGBAAt(1, 0); c != (color.RGBA{0x80, 0x40, 0x00, 0x80}) {
+		t.Errorf("Premultiplied pixel is %v", c)
+	}
+
+	nrgba, err := s.NRGBA()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := nrgba.NRGBAAt(0, 0); c != src.NRGBAAt(10, 10) {
+		t.Errorf("Opaque pixel is %v", c)
+	}
+	if c := nrgba.NRGBAAt(1, 0); c != src.NRGBAAt(11, 10) {
+		t.Errorf("Translucent pixel is %v", c)
+	}
+
+	s, err = cairo.ImageSurfaceCreateFromImage(rgba)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := s.RGBA(); again.RGBAAt(1, 0) != rgba.RGBAAt(1, 0) {
+		t.Error("RGBA round trip changed pixel")
+	}
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("read failed")
+}
+
+func TestPNG(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 3, 2))
+	src.SetRGBA(1, 1, color.RGBA{0x10, 0x20, 0x30, 0xff})
+	s, err := cairo.ImageSurfaceCreateFromImage(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := s.WriteToPNGStream(&buf); err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := png.Decode(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r, g, b, _ := decoded.At(1, 1).RGBA(); r>>8 != 0x10 || g>>8 != 0x20 || b>>8 != 0x30 {
+		t.Error("Decoded PNG has wrong pixel")
+	}
+
+	read, err := cairo.ImageSurfaceCreateFromPNGStream(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if img, _ := read.RGBA(); img.RGBAAt(1, 1) != src.RGBAAt(1, 1) {
+		t.Error("PNG stream round trip changed pixel")
+	}
+
+	dir, err := ioutil.TempDir("", "gotk3-cairo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "surface.png")
+	if err := s.WriteToPNG(path); err != nil {
+		t.Fatal(err)
+	}
+	read, err = cairo.ImageSurfaceCreateFromPNG(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read.GetWidth() != 3 || read.GetHeight() != 2 {
+		t.Error("PNG file round trip changed size")
+	}
+
+	if _, err := cairo.ImageSurfaceCreateFromPNG(filepath.Join(dir, "missing.png")); err == nil {
+		t.Error("Expected error for missing file")
+	}
+	if _, err := cairo.ImageSurfaceCreateFromPNGStream(failingReader{}); err == nil || err.Error() != "read failed" {
+		t.Errorf("Expected reader error, got %v", err)
+	}
+}
+
+func drawPages(t *testing.T, s *cairo.Surface, resize func(w, h float64)) {
+	ctx := newContext(t, s)
+	ctx.Rectangle(10, 10, 50, 50)
+	ctx.Fill()
+	ctx.ShowPage()
+	if resize != nil {
+		resize(200, 100)
+	}
+	ctx.Arc(50, 50, 20, 0, 6)
+	ctx.Stroke()
+	ctx.ShowPage()
+	if err := s.Finish(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestVectorSurfaces(t *testing.T) {
+	var buf bytes.Buffer
+	pdf, err := cairo.PDFSurfaceCreateForStream(&buf, 100, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pdf.RestrictToVersion(cairo.PDF_VERSION_1_4)
+	drawPages(t, pdf.Surface, pdf.SetSize)
+	if !bytes.HasPrefix(buf.Bytes(), []byte("%PDF-1.4")) {
+		t.Errorf("PDF output starts with %q", buf.Bytes()[:8])
+	}
+	if !bytes.Contains(buf.Bytes(), []byte("/Count 2")) {
+		t.Error("PDF output does not have two pages")
+	}
+
+	buf.Reset()
+	svg, err := cairo.SVGSurfaceCreateForStream(&buf, 100, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	svg.RestrictToVersion(cairo.SVG_VERSION_1_1)
+	drawPages(t, svg.Surface, nil)
+	if !strings.Contains(buf.String(), "<svg") {
+		t.Error("SVG output has no svg element")
+	}
+	if cairo.SVG_VERSION_1_1.String() != "SVG 1.1" {
+		t.Errorf("SVG version string is %q", cairo.SVG_VERSION_1_1)
+	}
+
+	buf.Reset()
+	ps, err := cairo.PSSurfaceCreateForStream(&buf, 100, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ps.SetEPS(true)
+	if !ps.GetEPS() {
+		t.Error("GetEPS returned false after SetEPS")
+	}
+	ctx := newContext(t, ps.Surface)
+	ctx.Paint()
+	ctx.ShowPage()
+	if err := ps.Finish(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(buf.String(), "%!PS-Adobe-3.0 EPSF-3.0") {
+		t.Error("PostScript output is not EPS")
+	}
+
+	dir, err := ioutil.TempDir("", "gotk3-cairo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "out.ps")
+	ps, err = cairo.PSSurfaceCreate(path, 100, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	drawPages(t, ps.Surface, ps.SetSize)
+	if fi, err := os.Stat(path); err != nil || fi.Size() == 0 {
+		t.Error("PostScript file was not written")
+	}
+}
+
+var errWrite = errors.New("write failed")
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errWrite
+}
+
+func TestStreamWriteError(t *testing.T) {
+	create := map[string]func() (*cairo.Surface, error){
+		"PDF": func() (*cairo.Surface, error) {
+			s, err := cairo.PDFSurfaceCreateForStream(failingWriter{}, 100, 100)
+			if err != nil {
+				return nil, err
+			}
+			return s.Surface, nil
+		},
+		"SVG": func() (*cairo.Surface, error) {
+			s, err := cairo.SVGSurfaceCreateForStream(failingWriter{}, 100, 100)
+			if err != nil {
+				return nil, err
+			}
+			return s.Surface, nil
+		},
+	}
+	for name, f := range create {
+		s, err := f()
+		if err == nil {
+			ctx := newContext(t, s)
+			ctx.Rectangle(10, 10, 50, 50)
+			ctx.Fill()
+			ctx.ShowPage()
+			err = s.Finish()
+		}
+		if err != errWrite {
+			t.Errorf("%s: expected writer error, got %v", name, err)
+		}
+	}
+}
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestMatrix(t *testing.T) {
+	var m cairo.Matrix
+	m.InitIdentity()
+	m.Translate(10, 20)
+	m.Scale(2, 3)
+	if x, y := m.TransformPoint(1, 1); !near(x, 12) || !near(y, 23) {
+		t.Errorf("TransformPoint returned %v, %v", x, y)
+	}
+	if dx, dy := m.TransformDistance(1, 1); !near(dx, 2) || !near(dy, 3) {
+		t.Errorf("TransformDistance returned %v, %v", dx, dy)
+	}
+
+	inv := m
+	if err := inv.Invert(); err != nil {
+		t.Fatal(err)
+	}
+	var product cairo.Matrix
+	product.Multiply(&m, &inv)
+	if x, y := product.TransformPoint(5, 7); !near(x, 5) || !near(y, 7) {
+		t.Error("Matrix times inverse is not the identity")
+	}
+
+	var rot cairo.Matrix
+	rot.InitRotate(math.Pi / 2)
+	if x, y := rot.TransformPoint(1, 0); !near(x, 0) || !near(y, 1) {
+		t.Errorf("Rotated point is %v, %v", x, y)
+	}
+
+	singular := cairo.NewMatrix(0, 0, 0, 0, 1, 1)
+	if err := singular.Invert(); err == nil {
+		t.Error("Expected error inverting singular matrix")
+	}
+}
+
+func TestContextTransform(t *testing.T) {
+	ctx := newContext(t, newImageSurface(t, 10, 10).Surface)
+	ctx.Translate(5, 5)
+	ctx.Scale(2, 2)
+	if x, y := ctx.UserToDevice(1, 1); !near(x, 7) || !near(y, 7) {
+		t.Errorf("UserToDevice returned %v, %v", x, y)
+	}
+	if x, y := ctx.DeviceToUser(7, 7); !near(x, 1) || !near(y, 1) {
+		t.Errorf("DeviceToUser returned %v, %v", x, y)
+	}
+	if dx, dy := ctx.UserToDeviceDistance(1, 1); !near(dx, 2) || !near(dy, 2) {
+		t.Errorf("UserToDeviceDistance returned %v, %v", dx, dy)
+	}
+
+	m := ctx.GetMatrix()
+	if *m != *cairo.NewMatrix(2, 0, 0, 2, 5, 5) {
+		t.Errorf("GetMatrix returned %v", *m)
+	}
+	ctx.IdentityMatrix()
+	ctx.Transform(m)
+	ctx.Rotate(0)
+	if *ctx.GetMatrix() != *m {
+		t.Error("Transform did not apply matrix")
+	}
+	ctx.SetMatrix(cairo.NewMatrix(1, 0, 0, 1, 0, 0))
+	if x, y := ctx.DeviceToUserDistance(3, 4); !near(x, 3) || !near(y, 4) {
+		t.Error("SetMatrix did not replace matrix")
+	}
+}
+
+func TestPatterns(t *testing.T) {
+	s := newImageSurface(t, 10, 1)
+	ctx := newContext(t, s.Surface)
+
+	linear := cairo.PatternCreateLinear(0, 0, 10, 0)
+	linear.AddColorStopRGB(0, 1, 0, 0)
+	linear.AddColorStopRGBA(1, 0, 0, 1, 1)
+	if n, err := linear.GetColorStopCount(); err != nil || n != 2 {
+		t.Errorf("GetColorStopCount returned %d, %v", n, err)
+	}
+	if o, r, _, _, a, err := linear.GetColorStopRGBA(0); err != nil || o != 0 || r != 1 || a != 1 {
+		t.Error("Unexpected first color stop")
+	}
+	if _, _, _, _, err := linear.GetRGBA(); err == nil {
+		t.Error("Expected error getting RGBA of a gradient")
+	}
+	ctx.SetSource(linear)
+	if ctx.GetSource().GetType() != cairo.PATTERN_TYPE_LINEAR {
+		t.Error("GetSource did not return the linear gradient")
+	}
+	ctx.Paint()
+	img, _ := s.RGBA()
+	if left, right := img.RGBAAt(0, 0), img.RGBAAt(9, 0); left.R < 0xe0 || left.B > 0x20 || right.B < 0xe0 || right.R > 0x20 {
+		t.Errorf("Gradient painted %v to %v", left, right)
+	}
+
+	// Tile a 2x1 surface of one opaque and one clear pixel.
+	tile := newImageSurface(t, 2, 1)
+	tctx := newContext(t, tile.Surface)
+	tctx.Rectangle(0, 0, 1, 1)
+	tctx.Fill()
+	tiled := cairo.PatternCreateForSurface(tile.Surface)
+	tiled.SetExtend(cairo.EXTEND_REPEAT)
+	tiled.SetFilter(cairo.FILTER_NEAREST)
+	if tiled.GetExtend() != cairo.EXTEND_REPEAT || tiled.GetFilter() != cairo.FILTER_NEAREST {
+		t.Error("Extend or filter not set")
+	}
+	if _, err := tiled.GetSurface(); err != nil {
+		t.Error(err)
+	}
+
+	ctx.SetOperator(cairo.OPERATOR_SOURCE)
+	ctx.SetSourceRGB(0, 1, 0)
+	ctx.Mask(tiled)
+	img, _ = s.RGBA()
+	for x := 0; x < 10; x++ {
+		if c := img.RGBAAt(x, 0); (x%2 == 0) != (c == color.RGBA{0, 0xff, 0, 0xff}) {
+			t.Errorf("Masked pixel %d is %v", x, c)
+		}
+	}
+
+	radial := cairo.PatternCreateRadial(5, 5, 0, 5, 5, 5)
+	var m cairo.Matrix
+	m.InitScale(2, 2)
+	radial.SetMatrix(&m)
+	if *radial.GetMatrix() != m {
+		t.Error("Pattern matrix not set")
+	}
+	if _, _, r0, _, _, r1, err := radial.GetRadialCircles(); err != nil || r0 != 0 || r1 != 5 {
+		t.Error("Unexpected radial circles")
+	}
+
+	ctx.PushGroup()
+	ctx.Paint()
+	if group := ctx.PopGroup(); group.GetType() != cairo.PATTERN_TYPE_SURFACE {
+		t.Error("PopGroup did not return a surface pattern")
+	}
+}
+
+func segment(typ cairo.PathDataType, coords ...float64) cairo.PathSegment {
+	seg := cairo.PathSegment{Type: typ}
+	for i := 0; i < len(coords); i += 2 {
+		seg.Points = append(seg.Points, cairo.Point{X: coords[i], Y: coords[i+1]})
+	}
+	return seg
+}
+
+func TestPath(t *testing.T) {
+	ctx := newContext(t, newImageSurface(t, 10, 10).Surface)
+	if ctx.HasCurrentPoint() {
+		t.Error("New context has a current point")
+	}
+	ctx.MoveTo(1, 2)
+	ctx.RelLineTo(3, 0)
+	ctx.RelCurveTo(1, 0, 1, 1, 0, 2)
+	ctx.ClosePath()
+	ctx.RelMoveTo(1, 1)
+	if !ctx.HasCurrentPoint() {
+		t.Error("No current point after drawing")
+	}
+
+	path, err := ctx.CopyPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := cairo.Path{
+		segment(cairo.PATH_MOVE_TO, 1, 2),
+		segment(cairo.PATH_LINE_TO, 4, 2),
+		segment(cairo.PATH_CURVE_TO, 5, 2, 5, 3, 4, 4),
+		segment(cairo.PATH_CLOSE_PATH),
+		segment(cairo.PATH_MOVE_TO, 2, 3),
+	}
+	if !reflect.DeepEqual(path, expected) {
+		t.Errorf("CopyPath returned %v", path)
+	}
+
+	flat, err := ctx.CopyPathFlat()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, seg := range flat {
+		if seg.Type == cairo.PATH_CURVE_TO {
+			t.Fatal("Flattened path has a curve")
+		}
+	}
+	if x1, y1, x2, y2 := ctx.PathExtents(); x1 != 1 || y1 != 2 || x2 < 4.5 || y2 != 4 {
+		t.Errorf("PathExtents returned %v, %v, %v, %v", x1, y1, x2, y2)
+	}
+
+	ctx.NewPath()
+	ctx.AppendPath(path)
+	if again, _ := ctx.CopyPath(); !reflect.DeepEqual(again, path) {
+		t.Errorf("AppendPath appended %v", again)
+	}
+	if !ctx.InFill(3, 3) {
+		t.Error("Appended path does not contain point")
+	}
+
+	ctx.AppendPath(cairo.Path{segment(cairo.PATH_CURVE_TO, 1, 1)})
+	if ctx.Status() != cairo.STATUS_INVALID_PATH_DATA {
+		t.Errorf("Status is %v after appending invalid path", ctx.Status())
+	}
+
+	ctx = newContext(t, newImageSurface(t, 10, 10).Surface)
+	ctx.AppendPath(cairo.Path{segment(cairo.PATH_MOVE_TO, 1, 1, 2, 2)})
+	if ctx.Status() != cairo.STATUS_INVALID_PATH_DATA {
+		t.Errorf("Status is %v after appending segment with extra points", ctx.Status())
+	}
+}
+
+func TestText(t *testing.T) {
+	s := newImageSurface(t, 100, 40)
+	ctx := newContext(t, s.Surface)
+	ctx.SelectFontFace("sans-serif", cairo.FONT_SLANT_NORMAL, cairo.FONT_WEIGHT_BOLD)
+	ctx.SetFontSize(20)
+	if m := ctx.GetFontMatrix(); m.Xx != 20 || m.Yy != 20 {
+		t.Errorf("Font matrix is %v", *m)
+	}
+
+	short, long := ctx.TextExtents("i"), ctx.TextExtents("iii")
+	if short.XAdvance <= 0 || long.XAdvance <= short.XAdvance {
+		t.Errorf("Unexpected advances %v and %v", short.XAdvance, long.XAdvance)
+	}
+	fe := ctx.FontExtents()
+	if fe.Ascent <= 0 || fe.Height < fe.Ascent {
+		t.Errorf("Unexpected font extents %+v", fe)
+	}
+
+	ctx.MoveTo(0, 30)
+	ctx.ShowText("gotk3")
+	if x, _ := ctx.GetCurrentPoint(); x <= 0 {
+		t.Error("ShowText did not advance the current point")
+	}
+	ctx.NewPath()
+	ctx.MoveTo(0, 30)
+	ctx.TextPath("gotk3")
+	if path, _ := ctx.CopyPath(); len(path) < 2 {
+		t.Error("TextPath added no outlines")
+	}
+	ctx.NewPath()
+
+	glyphs := []cairo.Glyph{{Index: 36, X: 10, Y: 30}, {Index: 37, X: 30, Y: 30}}
+	ctx.ShowGlyphs(glyphs)
+	ctx.GlyphPath(glyphs)
+	if e := ctx.GlyphExtents(glyphs); e.Width <= 0 {
+		t.Errorf("Unexpected glyph extents %+v", e)
+	}
+	if ctx.Status() != cairo.STATUS_SUCCESS {
+		t.Error("Status is", ctx.Status())
+	}
+}
+
+func TestFontOptions(t *testing.T) {
+	options := cairo.FontOptionsCreate()
+	options.SetAntialias(cairo.ANTIALIAS_GRAY)
+	options.SetSubpixelOrder(cairo.SUBPIXEL_ORDER_BGR)
+	options.SetHintStyle(cairo.HINT_STYLE_SLIGHT)
+	options.SetHintMetrics(cairo.HINT_METRICS_OFF)
+	if options.GetAntialias() != cairo.ANTIALIAS_GRAY ||
+		options.GetSubpixelOrder() != cairo.SUBPIXEL_ORDER_BGR ||
+		options.GetHintStyle() != cairo.HINT_STYLE_SLIGHT ||
+		options.GetHintMetrics() != cairo.HINT_METRICS_OFF {
+		t.Error("Font options not set")
+	}
+
+	copied := options.Copy()
+	if !copied.Equal(options) || copied.Hash() != options.Hash() {
+		t.Error("Copy is not equal to original")
+	}
+	merged := cairo.FontOptionsCreate()
+	merged.SetHintStyle(cairo.HINT_STYLE_FULL)
+	merged.Merge(options)
+	if merged.GetHintStyle() != cairo.HINT_STYLE_SLIGHT || merged.GetAntialias() != cairo.ANTIALIAS_GRAY {
+		t.Error("Merge did not override options")
+	}
+
+	s := newImageSurface(t, 1, 1)
+	if s.GetFontOptions().Status() != cairo.STATUS_SUCCESS {
+		t.Error("Surface font options have an error")
+	}
+	ctx := newContext(t, s.Surface)
+	ctx.SetFontOptions(options)
+	if !ctx.GetFontOptions().Equal(options) {
+		t.Error("Context font options not set")
+	}
+}
+
+func TestRegion(t *testing.T) {
+	r := cairo.RegionCreate()
+	if !r.IsEmpty() {
+		t.Error("New region is not empty")
+	}
+	if err := r.UnionRectangle(&cairo.RectangleInt{X: 0, Y: 0, Width: 10, Height: 10}); err != nil {
+		t.Fatal(err)
+	}
+	other := cairo.RegionCreateRectangles([]cairo.RectangleInt{
+		{X: 5, Y: 5, Width: 10, Height: 10},
+	})
+	if err := r.Union(other); err != nil {
+		t.Fatal(err)
+	}
+	if e := r.GetExtents(); e != (cairo.RectangleInt{X: 0, Y: 0, Width: 15, Height: 15}) {
+		t.Errorf("Extents are %v", e)
+	}
+	if !r.ContainsPoint(12, 12) || r.ContainsPoint(12, 2) {
+		t.Error("Unexpected ContainsPoint result")
+	}
+	if o := r.ContainsRectangle(&cairo.RectangleInt{X: 8, Y: 0, Width: 4, Height: 2}); o != cairo.REGION_OVERLAP_PART {
+		t.Errorf("Overlap is %v", o)
+	}
+	area := 0
+	for _, rect := range r.Rectangles() {
+		area += rect.Width * rect.Height
+	}
+	if area != 175 {
+		t.Errorf("Union covers %d pixels", area)
+	}
+
+	xor := r.Copy()
+	xor.Xor(other)
+	inter := r.Copy()
+	inter.Intersect(other)
+	sub := r.Copy()
+	sub.Subtract(other)
+	if !xor.Equal(sub) || inter.NumRectangles() != 1 {
+		t.Error("Unexpected set operation results")
+	}
+	if !inter.Equal(cairo.RegionCreateRectangle(&cairo.RectangleInt{X: 5, Y: 5, Width: 5, Height: 5})) {
+		t.Error("Intersection is wrong")
+	}
+
+	sub.SubtractRectangle(&cairo.RectangleInt{X: 0, Y: 0, Width: 10, Height: 10})
+	inter.IntersectRectangle(&cairo.RectangleInt{X: 0, Y: 0, Width: 1, Height: 1})
+	if !sub.IsEmpty() || !inter.IsEmpty() {
+		t.Error("Expected empty regions")
+	}
+	inter.XorRectangle(&cairo.RectangleInt{X: 0, Y: 0, Width: 1, Height: 1})
+	inter.Translate(2, 3)
+	if !inter.ContainsPoint(2, 3) {
+		t.Error("Translate did not move region")
+	}
+}
+
+func drawScene(ctx *cairo.Context) {
+	ctx.SetSourceRGB(1, 0, 0)
+	ctx.Rectangle(1, 1, 4, 3)
+	ctx.Fill()
+	ctx.SetSourceRGBA(0, 0, 1, 0.5)
+	ctx.Arc(6, 6, 3, 0, 2*math.Pi)
+	ctx.Fill()
+}
+
+func TestRecordingSurface(t *testing.T) {
+	rec, err := cairo.RecordingSurfaceCreate(cairo.CONTENT_COLOR_ALPHA, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	drawScene(newContext(t, rec.Surface))
+	if x, y, w, h := rec.InkExtents(); math.Abs(x-1) > 0.5 || math.Abs(y-1) > 0.5 ||
+		math.Abs(w-8) > 1 || math.Abs(h-8) > 1 {
+		t.Errorf("Ink extents are %v, %v, %v, %v", x, y, w, h)
+	}
+
+	// Replaying the recording scaled must match drawing directly at
+	// that scale.
+	replayed := newImageSurface(t, 20, 20)
+	ctx := newContext(t, replayed.Surface)
+	ctx.Scale(2, 2)
+	ctx.SetSource(cairo.PatternCreateForSurface(rec.Surface))
+	ctx.Paint()
+
+	direct := newImageSurface(t, 20, 20)
+	ctx = newContext(t, direct.Surface)
+	ctx.Scale(2, 2)
+	drawScene(ctx)
+
+	got, err := replayed.RGBA()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := direct.RGBA()
+	if !bytes.Equal(got.Pix, want.Pix) {
+		t.Error("Replayed recording differs from direct drawing")
+	}
+	if got.RGBAAt(4, 4) != (color.RGBA{0xff, 0, 0, 0xff}) {
+		t.Errorf("Replayed pixel is %v", got.RGBAAt(4, 4))
+	}
+
+	bounded, err := cairo.RecordingSurfaceCreate(cairo.CONTENT_COLOR_ALPHA,
+		&cairo.Rectangle{X: 0, Y: 0, Width: 4, Height: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx = newContext(t, bounded.Surface)
+	drawScene(ctx)
+	if _, _, w, h := bounded.InkExtents(); w > 4 || h > 4 {
+		t.Error("Recording was not clipped to its extents")
+	}
+}
+
+func TestStatusErrors(t *testing.T) {
+	if cairo.STATUS_SUCCESS.ToError() != nil {
+		t.Error("Success converted to an error")
+	}
+	err := cairo.STATUS_NO_MEMORY.ToError()
+	if err != cairo.STATUS_NO_MEMORY || err.Error() != "out of memory" {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	if _, err := cairo.ImageSurfaceCreate(cairo.FORMAT_ARGB32, -1, 1); err != cairo.STATUS_INVALID_SIZE {
+		t.Errorf("Expected invalid size error, got %v", err)
+	}
+
+	ctx := newContext(t, newImageSurface(t, 1, 1).Surface)
+	ctx.Restore()
+	ctx.Paint()
+	if err := ctx.Err(); err != cairo.STATUS_INVALID_RESTORE {
+		t.Errorf("Expected invalid restore error, got %v", err)
+	}
+
+	var m cairo.Matrix
+	if err := m.Invert(); err != cairo.STATUS_INVALID_MATRIX {
+		t.Errorf("Expected invalid matrix error, got %v", err)
+	}
+}
+
+func TestSetMimeData(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 8, 8))
+	for i := range src.Pix {
+		src.Pix[i] = uint8(i)
+	}
+	var jpg bytes.Buffer
+	if err := jpeg.Encode(&jpg, src, nil); err != nil {
+		t.Fatal(err)
+	}
+	img, err := cairo.ImageSurfaceCreateFromImage(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := img.SetMimeData(cairo.MIME_TYPE_JPEG, jpg.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(img.GetMimeData(cairo.MIME_TYPE_JPEG), jpg.Bytes()) {
+		t.Error("GetMimeData did not return attached data")
+	}
+
+	var out bytes.Buffer
+	pdf, err := cairo.PDFSurfaceCreateForStream(&out, 8, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSupportsMimeType(t, pdf.Surface, img.Surface)
+	ctx := newContext(t, pdf.Surface)
+	ctx.SetSourceSurface(img.Surface, 0, 0)
+	ctx.Paint()
+	if err := pdf.Finish(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(out.Bytes(), jpg.Bytes()) {
+		t.Error("PDF does not embed the attached JPEG")
+	}
+	if !bytes.Contains(out.Bytes(), []byte("/DCTDecode")) {
+		t.Error("PDF image is not JPEG encoded")
+	}
+
+	if err := img.SetMimeData(cairo.MIME_TYPE_JPEG, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(img.GetMimeData(cairo.MIME_TYPE_JPEG)) != 0 {
+		t.Error("Mime data was not removed")
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package cairo
+
+// #cgo pkg-config: cairo cairo-gobject
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include <cairo-gobject.h>
+// #include "cairo.go.h"
+import "C"
+import (
+	"runtime"
+	"unsafe"
+
+	"github.com/conformal/gotk3/glib"
+)
+
+func init() {
+	tm := []glib.TypeMarshaler{
+		// Boxed
+		{glib.Type(C.cairo_gobject_device_get_type()), marshalDevice},
+	}
+	glib.RegisterGValueMarshalers(tm)
+}
+
+/*
+ * cairo_device_t
+ */
+
+// Device is a representation of Cairo's cairo_device_t, the output
+// device shared by a group of surfaces.
+type Device struct {
+	device *C.cairo_device_t
+}
+
+// native returns a pointer to the underlying cairo_device_t.
+func (v *Device) native() *C.cairo_device_t {
+	if v == nil {
+		return nil
+	}
+	return v.device
+}
+
+// Native returns a pointer to the underlying cairo_device_t.
+func (v *Device) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalDevice(p uintptr) (interface{}, error) {
+	c := C.g_value_get_boxed((*C.GValue)(unsafe.Pointer(p)))
+	device := (*C.cairo_device_t)(unsafe.Pointer(c))
+	return refDevice(device), nil
+}
+
+func wrapDevice(device *C.cairo_device_t) *Device {
+	return &Device{device}
+}
+
+// takeDevice wraps a newly-created device, returning an error if it is
+// in an error state.
+func takeDevice(device *C.cairo_device_t) (*Device, error) {
+	d := wrapDevice(device)
+	runtime.SetFinalizer(d, (*Device).destroy)
+	if err := statusError(C.cairo_device_status(device)); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// refDevice wraps a device owned by Cairo, taking a new reference.
+func refDevice(device *C.cairo_device_t) *Device {
+	d := wrapDevice(device)
+	d.reference()
+	runtime.SetFinalizer(d, (*Device).destroy)
+	return d
+}
+
+// reference is a wrapper around cairo_device_reference().
+func (v *Device) reference() {
+	v.device = C.cairo_device_reference(v.native())
+}
+
+// destroy is a wrapper around cairo_device_destroy().
+func (v *Device) destroy() {
+	C.cairo_device_destroy(v.native())
+}
+
+// Status is a wrapper around cairo_device_status().
+func (v *Device) Status() Status {
+	c := C.cairo_device_status(v.native())
+	return Status(c)
+}
+
+// Err returns the error of the device, or nil if it is not in an error
+// state.
+func (v *Device) Err() error {
+	return v.Status().ToError()
+}
+
+// Flush is a wrapper around cairo_device_flush().
+func (v *Device) Flush() {
+	C.cairo_device_flush(v.native())
+}
+
+// Finish is a wrapper around cairo_device_finish().  Once Finish returns,
+// all output of a device writing to a file or io.Writer has been written,
+// and any error writing it is returned.
+func (v *Device) Finish() error {
+	C.cairo_device_finish(v.native())
+	p := C._cairo_device_get_go_data(v.native())
+	if s, ok := getHandle(p).(*stream); ok && s.err != nil {
+		return s.err
+	}
+	return v.Err()
+}
+
+// GetDevice is a wrapper around cairo_surface_get_device().  It returns
+// nil if the surface has no device.
+func (v *Surface) GetDevice() *Device {
+	c := C.cairo_surface_get_device(v.native())
+	if c == nil {
+		return nil
+	}
+	return refDevice(c)
+}
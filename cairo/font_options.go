@@ -0,0 +1,235 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package cairo
+
+// #cgo pkg-config: cairo cairo-gobject
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include <cairo-gobject.h>
+import "C"
+import (
+	"runtime"
+	"unsafe"
+
+	"github.com/conformal/gotk3/glib"
+)
+
+func init() {
+	tm := []glib.TypeMarshaler{
+		// Enums
+		{glib.Type(C.cairo_gobject_hint_metrics_get_type()), marshalHintMetrics},
+		{glib.Type(C.cairo_gobject_hint_style_get_type()), marshalHintStyle},
+		{glib.Type(C.cairo_gobject_subpixel_order_get_type()), marshalSubpixelOrder},
+
+		// Boxed
+		{glib.Type(C.cairo_gobject_font_options_get_type()), marshalFontOptions},
+	}
+	glib.RegisterGValueMarshalers(tm)
+}
+
+// HintMetrics is a representation of Cairo's cairo_hint_metrics_t.
+type HintMetrics int
+
+const (
+	HINT_METRICS_DEFAULT HintMetrics = C.CAIRO_HINT_METRICS_DEFAULT
+	HINT_METRICS_OFF     HintMetrics = C.CAIRO_HINT_METRICS_OFF
+	HINT_METRICS_ON      HintMetrics = C.CAIRO_HINT_METRICS_ON
+)
+
+func marshalHintMetrics(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return HintMetrics(c), nil
+}
+
+// HintStyle is a representation of Cairo's cairo_hint_style_t.
+type HintStyle int
+
+const (
+	HINT_STYLE_DEFAULT HintStyle = C.CAIRO_HINT_STYLE_DEFAULT
+	HINT_STYLE_NONE    HintStyle = C.CAIRO_HINT_STYLE_NONE
+	HINT_STYLE_SLIGHT  HintStyle = C.CAIRO_HINT_STYLE_SLIGHT
+	HINT_STYLE_MEDIUM  HintStyle = C.CAIRO_HINT_STYLE_MEDIUM
+	HINT_STYLE_FULL    HintStyle = C.CAIRO_HINT_STYLE_FULL
+)
+
+func marshalHintStyle(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return HintStyle(c), nil
+}
+
+// SubpixelOrder is a representation of Cairo's cairo_subpixel_order_t.
+type SubpixelOrder int
+
+const (
+	SUBPIXEL_ORDER_DEFAULT SubpixelOrder = C.CAIRO_SUBPIXEL_ORDER_DEFAULT
+	SUBPIXEL_ORDER_RGB     SubpixelOrder = C.CAIRO_SUBPIXEL_ORDER_RGB
+	SUBPIXEL_ORDER_BGR     SubpixelOrder = C.CAIRO_SUBPIXEL_ORDER_BGR
+	SUBPIXEL_ORDER_VRGB    SubpixelOrder = C.CAIRO_SUBPIXEL_ORDER_VRGB
+	SUBPIXEL_ORDER_VBGR    SubpixelOrder = C.CAIRO_SUBPIXEL_ORDER_VBGR
+)
+
+func marshalSubpixelOrder(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return SubpixelOrder(c), nil
+}
+
+/*
+ * cairo_font_options_t
+ */
+
+// FontOptions is a representation of Cairo's cairo_font_options_t, which
+// controls how fonts are rendered.
+type FontOptions struct {
+	fontOptions *C.cairo_font_options_t
+}
+
+// native returns a pointer to the underlying cairo_font_options_t.
+func (v *FontOptions) native() *C.cairo_font_options_t {
+	if v == nil {
+		return nil
+	}
+	return v.fontOptions
+}
+
+// Native returns a pointer to the underlying cairo_font_options_t.
+func (v *FontOptions) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalFontOptions(p uintptr) (interface{}, error) {
+	c := C.g_value_get_boxed((*C.GValue)(unsafe.Pointer(p)))
+	options := (*C.cairo_font_options_t)(unsafe.Pointer(c))
+	// Font options are not reference counted, so take a copy.
+	return takeFontOptions(C.cairo_font_options_copy(options)), nil
+}
+
+// takeFontOptions wraps a newly-created cairo_font_options_t.
+func takeFontOptions(options *C.cairo_font_options_t) *FontOptions {
+	o := &FontOptions{options}
+	runtime.SetFinalizer(o, (*FontOptions).destroy)
+	return o
+}
+
+// FontOptionsCreate is a wrapper around cairo_font_options_create().
+func FontOptionsCreate() *FontOptions {
+	c := C.cairo_font_options_create()
+	return takeFontOptions(c)
+}
+
+// Copy is a wrapper around cairo_font_options_copy().
+func (v *FontOptions) Copy() *FontOptions {
+	c := C.cairo_font_options_copy(v.native())
+	return takeFontOptions(c)
+}
+
+// destroy is a wrapper around cairo_font_options_destroy().
+func (v *FontOptions) destroy() {
+	C.cairo_font_options_destroy(v.native())
+}
+
+// Status is a wrapper around cairo_font_options_status().
+func (v *FontOptions) Status() Status {
+	c := C.cairo_font_options_status(v.native())
+	return Status(c)
+}
+
+// Merge is a wrapper around cairo_font_options_merge().  Options of other
+// which are not set to their default values override those of v.
+func (v *FontOptions) Merge(other *FontOptions) {
+	C.cairo_font_options_merge(v.native(), other.native())
+}
+
+// Equal is a wrapper around cairo_font_options_equal().
+func (v *FontOptions) Equal(other *FontOptions) bool {
+	c := C.cairo_font_options_equal(v.native(), other.native())
+	return gobool(c)
+}
+
+// Hash is a wrapper around cairo_font_options_hash().
+func (v *FontOptions) Hash() uint64 {
+	c := C.cairo_font_options_hash(v.native())
+	return uint64(c)
+}
+
+// SetAntialias is a wrapper around cairo_font_options_set_antialias().
+func (v *FontOptions) SetAntialias(antialias Antialias) {
+	C.cairo_font_options_set_antialias(v.native(),
+		C.cairo_antialias_t(antialias))
+}
+
+// GetAntialias is a wrapper around cairo_font_options_get_antialias().
+func (v *FontOptions) GetAntialias() Antialias {
+	c := C.cairo_font_options_get_antialias(v.native())
+	return Antialias(c)
+}
+
+// SetSubpixelOrder is a wrapper around
+// cairo_font_options_set_subpixel_order().
+func (v *FontOptions) SetSubpixelOrder(order SubpixelOrder) {
+	C.cairo_font_options_set_subpixel_order(v.native(),
+		C.cairo_subpixel_order_t(order))
+}
+
+// GetSubpixelOrder is a wrapper around
+// cairo_font_options_get_subpixel_order().
+func (v *FontOptions) GetSubpixelOrder() SubpixelOrder {
+	c := C.cairo_font_options_get_subpixel_order(v.native())
+	return SubpixelOrder(c)
+}
+
+// SetHintStyle is a wrapper around cairo_font_options_set_hint_style().
+func (v *FontOptions) SetHintStyle(style HintStyle) {
+	C.cairo_font_options_set_hint_style(v.native(),
+		C.cairo_hint_style_t(style))
+}
+
+// GetHintStyle is a wrapper around cairo_font_options_get_hint_style().
+func (v *FontOptions) GetHintStyle() HintStyle {
+	c := C.cairo_font_options_get_hint_style(v.native())
+	return HintStyle(c)
+}
+
+// SetHintMetrics is a wrapper around cairo_font_options_set_hint_metrics().
+func (v *FontOptions) SetHintMetrics(metrics HintMetrics) {
+	C.cairo_font_options_set_hint_metrics(v.native(),
+		C.cairo_hint_metrics_t(metrics))
+}
+
+// GetHintMetrics is a wrapper around cairo_font_options_get_hint_metrics().
+func (v *FontOptions) GetHintMetrics() HintMetrics {
+	c := C.cairo_font_options_get_hint_metrics(v.native())
+	return HintMetrics(c)
+}
+
+// SetFontOptions is a wrapper around cairo_set_font_options().
+func (v *Context) SetFontOptions(options *FontOptions) {
+	C.cairo_set_font_options(v.native(), options.native())
+}
+
+// GetFontOptions is a wrapper around cairo_get_font_options().
+func (v *Context) GetFontOptions() *FontOptions {
+	options := FontOptionsCreate()
+	C.cairo_get_font_options(v.native(), options.native())
+	return options
+}
+
+// GetFontOptions is a wrapper around cairo_surface_get_font_options().
+func (v *Surface) GetFontOptions() *FontOptions {
+	options := FontOptionsCreate()
+	C.cairo_surface_get_font_options(v.native(), options.native())
+	return options
+}
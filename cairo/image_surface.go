@@ -0,0 +1,262 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package cairo
+
+// #cgo pkg-config: cairo cairo-gobject
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include <cairo-gobject.h>
+// #include "cairo.go.h"
+import "C"
+import (
+	"errors"
+	"image"
+	"image/color"
+	"reflect"
+	"unsafe"
+)
+
+// FormatStrideForWidth is a wrapper around cairo_format_stride_for_width().
+// It returns -1 if the format is invalid or the width is too large.
+func FormatStrideForWidth(format Format, width int) int {
+	c := C.cairo_format_stride_for_width(C.cairo_format_t(format),
+		C.int(width))
+	return int(c)
+}
+
+/*
+ * cairo_image_surface_t
+ */
+
+// ImageSurface is a representation of a Cairo image surface, a surface
+// which renders to a buffer of pixels in memory.
+type ImageSurface struct {
+	*Surface
+}
+
+// takeImageSurface wraps a newly-created image surface, returning an
+// error if it is in an error state.
+func takeImageSurface(c *C.cairo_surface_t) (*ImageSurface, error) {
+	s, err := takeSurface(c)
+	if err != nil {
+		return nil, err
+	}
+	return &ImageSurface{s}, nil
+}
+
+// ImageSurfaceCreate is a wrapper around cairo_image_surface_create().
+// The surface contents are initially cleared to transparent black.
+func ImageSurfaceCreate(format Format, width, height int) (*ImageSurface, error) {
+	c := C.cairo_image_surface_create(C.cairo_format_t(format),
+		C.int(width), C.int(height))
+	return takeImageSurface(c)
+}
+
+// ImageSurfaceCreateForData is a wrapper around
+// cairo_image_surface_create_for_data().  The surface draws directly
+// into data, which is kept alive until the surface is destroyed.  The
+// stride must be one returned by FormatStrideForWidth for the format and
+// width, and data must hold at least stride*height bytes.
+func ImageSurfaceCreateForData(data []byte, format Format, width, height, stride int) (*ImageSurface, error) {
+	if stride < FormatStrideForWidth(format, width) || stride%4 != 0 {
+		return nil, errors.New("invalid stride for image surface")
+	}
+	if width < 0 || height < 0 || len(data) < stride*height {
+		return nil, errors.New("image data too small for surface")
+	}
+	var p *C.uchar
+	if len(data) > 0 {
+		p = (*C.uchar)(unsafe.Pointer(&data[0]))
+	}
+	c := C.cairo_image_surface_create_for_data(p, C.cairo_format_t(format),
+		C.int(width), C.int(height), C.int(stride))
+	s, err := takeImageSurface(c)
+	if err != nil {
+		return nil, err
+	}
+	h := newHandle(data)
+	if err := statusError(C._cairo_surface_set_go_data(c, h)); err != nil {
+		freeHandle(h)
+		return nil, err
+	}
+	return s, nil
+}
+
+// ImageSurfaceCreateFromImage creates an ARGB32 image surface holding a
+// copy of img.  Colors are stored premultiplied by alpha, as Cairo
+// requires.
+func ImageSurfaceCreateFromImage(img image.Image) (*ImageSurface, error) {
+	b := img.Bounds()
+	s, err := ImageSurfaceCreate(FORMAT_ARGB32, b.Dx(), b.Dy())
+	if err != nil {
+		return nil, err
+	}
+	data := s.GetData()
+	if data == nil {
+		return s, nil
+	}
+	stride := s.GetStride()
+	for y := 0; y < b.Dy(); y++ {
+		row := data[y*stride:]
+		for x := 0; x < b.Dx(); x++ {
+			var r, g, bl, a uint32
+			switch img := img.(type) {
+			case *image.RGBA:
+				i := img.PixOffset(b.Min.X+x, b.Min.Y+y)
+				r, g, bl, a = uint32(img.Pix[i]), uint32(img.Pix[i+1]),
+					uint32(img.Pix[i+2]), uint32(img.Pix[i+3])
+			case *image.NRGBA:
+				i := img.PixOffset(b.Min.X+x, b.Min.Y+y)
+				a = uint32(img.Pix[i+3])
+				r = premultiply(img.Pix[i], a)
+				g = premultiply(img.Pix[i+1], a)
+				bl = premultiply(img.Pix[i+2], a)
+			default:
+				r, g, bl, a = img.At(b.Min.X+x, b.Min.Y+y).RGBA()
+				r, g, bl, a = r>>8, g>>8, bl>>8, a>>8
+			}
+			*(*uint32)(unsafe.Pointer(&row[4*x])) = a<<24 | r<<16 | g<<8 | bl
+		}
+	}
+	s.MarkDirty()
+	return s, nil
+}
+
+// premultiply scales an 8-bit color channel by an 8-bit alpha.
+func premultiply(c uint8, a uint32) uint32 {
+	return (uint32(c)*a + 127) / 255
+}
+
+// unpremultiply reverses premultiply.
+func unpremultiply(c, a uint32) uint8 {
+	if a == 0 {
+		return 0
+	}
+	return uint8((c*255 + a/2) / a)
+}
+
+// GetData is a wrapper around cairo_image_surface_get_data().  The
+// returned slice refers to the surface's memory and is only valid while
+// the surface is.  Call Flush before reading the data after drawing with
+// Cairo, and MarkDirty after modifying it.  A nil slice is returned if
+// the surface has an error or is finished.
+func (v *ImageSurface) GetData() (data []byte) {
+	c := C.cairo_image_surface_get_data(v.native())
+	if c == nil {
+		return nil
+	}
+	n := v.GetStride() * v.GetHeight()
+	header := (*reflect.SliceHeader)(unsafe.Pointer(&data))
+	header.Data = uintptr(unsafe.Pointer(c))
+	header.Len = n
+	header.Cap = n
+	return data
+}
+
+// GetFormat is a wrapper around cairo_image_surface_get_format().
+func (v *ImageSurface) GetFormat() Format {
+	c := C.cairo_image_surface_get_format(v.native())
+	return Format(c)
+}
+
+// GetWidth is a wrapper around cairo_image_surface_get_width().
+func (v *ImageSurface) GetWidth() int {
+	c := C.cairo_image_surface_get_width(v.native())
+	return int(c)
+}
+
+// GetHeight is a wrapper around cairo_image_surface_get_height().
+func (v *ImageSurface) GetHeight() int {
+	c := C.cairo_image_surface_get_height(v.native())
+	return int(c)
+}
+
+// GetStride is a wrapper around cairo_image_surface_get_stride().
+func (v *ImageSurface) GetStride() int {
+	c := C.cairo_image_surface_get_stride(v.native())
+	return int(c)
+}
+
+// pixels calls f with the premultiplied color of each pixel of the
+// surface.  Only the ARGB32, RGB24 and A8 formats are supported.
+func (v *ImageSurface) pixels(f func(x, y int, r, g, b, a uint32)) error {
+	format := v.GetFormat()
+	switch format {
+	case FORMAT_ARGB32, FORMAT_RGB24, FORMAT_A8:
+	default:
+		return errors.New("unsupported image surface format")
+	}
+	v.Flush()
+	data := v.GetData()
+	if data == nil {
+		return errors.New("image surface has no data")
+	}
+	stride := v.GetStride()
+	w, h := v.GetWidth(), v.GetHeight()
+	for y := 0; y < h; y++ {
+		row := data[y*stride:]
+		for x := 0; x < w; x++ {
+			if format == FORMAT_A8 {
+				f(x, y, 0, 0, 0, uint32(row[x]))
+				continue
+			}
+			p := *(*uint32)(unsafe.Pointer(&row[4*x]))
+			a := p >> 24
+			if format == FORMAT_RGB24 {
+				a = 0xff
+			}
+			f(x, y, p>>16&0xff, p>>8&0xff, p&0xff, a)
+		}
+	}
+	return nil
+}
+
+// RGBA returns a copy of the surface contents as an *image.RGBA.  Like
+// Cairo, image.RGBA stores premultiplied colors, so the pixels are
+// copied unchanged.
+func (v *ImageSurface) RGBA() (*image.RGBA, error) {
+	img := image.NewRGBA(image.Rect(0, 0, v.GetWidth(), v.GetHeight()))
+	err := v.pixels(func(x, y int, r, g, b, a uint32) {
+		i := img.PixOffset(x, y)
+		img.Pix[i] = uint8(r)
+		img.Pix[i+1] = uint8(g)
+		img.Pix[i+2] = uint8(b)
+		img.Pix[i+3] = uint8(a)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return img, nil
+}
+
+// NRGBA returns a copy of the surface contents as an *image.NRGBA, with
+// the premultiplied colors of the surface converted to straight alpha.
+func (v *ImageSurface) NRGBA() (*image.NRGBA, error) {
+	img := image.NewNRGBA(image.Rect(0, 0, v.GetWidth(), v.GetHeight()))
+	err := v.pixels(func(x, y int, r, g, b, a uint32) {
+		img.SetNRGBA(x, y, color.NRGBA{
+			unpremultiply(r, a),
+			unpremultiply(g, a),
+			unpremultiply(b, a),
+			uint8(a),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return img, nil
+}
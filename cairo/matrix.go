@@ -0,0 +1,118 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package cairo
+
+// #cgo pkg-config: cairo cairo-gobject
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include <cairo-gobject.h>
+import "C"
+import (
+	"unsafe"
+)
+
+/*
+ * cairo_matrix_t
+ */
+
+// Matrix is a representation of Cairo's cairo_matrix_t, an affine
+// transformation.  A point (x, y) is transformed to
+//
+//	x_new = Xx*x + Xy*y + X0
+//	y_new = Yx*x + Yy*y + Y0
+//
+// Matrix has the same memory layout as cairo_matrix_t and may be used as a
+// value type.  The zero Matrix is not the identity; call InitIdentity.
+type Matrix struct {
+	Xx, Yx float64
+	Xy, Yy float64
+	X0, Y0 float64
+}
+
+// native returns a pointer to the underlying cairo_matrix_t.
+func (v *Matrix) native() *C.cairo_matrix_t {
+	return (*C.cairo_matrix_t)(unsafe.Pointer(v))
+}
+
+// NewMatrix is a wrapper around cairo_matrix_init().
+func NewMatrix(xx, yx, xy, yy, x0, y0 float64) *Matrix {
+	return &Matrix{xx, yx, xy, yy, x0, y0}
+}
+
+// InitIdentity is a wrapper around cairo_matrix_init_identity().
+func (v *Matrix) InitIdentity() {
+	C.cairo_matrix_init_identity(v.native())
+}
+
+// InitTranslate is a wrapper around cairo_matrix_init_translate().
+func (v *Matrix) InitTranslate(tx, ty float64) {
+	C.cairo_matrix_init_translate(v.native(), C.double(tx), C.double(ty))
+}
+
+// InitScale is a wrapper around cairo_matrix_init_scale().
+func (v *Matrix) InitScale(sx, sy float64) {
+	C.cairo_matrix_init_scale(v.native(), C.double(sx), C.double(sy))
+}
+
+// InitRotate is a wrapper around cairo_matrix_init_rotate().
+func (v *Matrix) InitRotate(radians float64) {
+	C.cairo_matrix_init_rotate(v.native(), C.double(radians))
+}
+
+// Translate is a wrapper around cairo_matrix_translate().
+func (v *Matrix) Translate(tx, ty float64) {
+	C.cairo_matrix_translate(v.native(), C.double(tx), C.double(ty))
+}
+
+// Scale is a wrapper around cairo_matrix_scale().
+func (v *Matrix) Scale(sx, sy float64) {
+	C.cairo_matrix_scale(v.native(), C.double(sx), C.double(sy))
+}
+
+// Rotate is a wrapper around cairo_matrix_rotate().
+func (v *Matrix) Rotate(radians float64) {
+	C.cairo_matrix_rotate(v.native(), C.double(radians))
+}
+
+// Invert is a wrapper around cairo_matrix_invert().  If the matrix has
+// no inverse, an error is returned and the matrix is unchanged.
+func (v *Matrix) Invert() error {
+	c := C.cairo_matrix_invert(v.native())
+	return statusError(c)
+}
+
+// Multiply is a wrapper around cairo_matrix_multiply().  The matrix is
+// set to the product of a and b, which applies the transformation of a
+// and then that of b.  The receiver may be a or b.
+func (v *Matrix) Multiply(a, b *Matrix) {
+	C.cairo_matrix_multiply(v.native(), a.native(), b.native())
+}
+
+// TransformDistance is a wrapper around cairo_matrix_transform_distance().
+// The translation components of the matrix are ignored.
+func (v *Matrix) TransformDistance(dx, dy float64) (float64, float64) {
+	cdx, cdy := C.double(dx), C.double(dy)
+	C.cairo_matrix_transform_distance(v.native(), &cdx, &cdy)
+	return float64(cdx), float64(cdy)
+}
+
+// TransformPoint is a wrapper around cairo_matrix_transform_point().
+func (v *Matrix) TransformPoint(x, y float64) (float64, float64) {
+	cx, cy := C.double(x), C.double(y)
+	C.cairo_matrix_transform_point(v.native(), &cx, &cy)
+	return float64(cx), float64(cy)
+}
@@ -0,0 +1,261 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package cairo
+
+// #cgo pkg-config: cairo cairo-gobject
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include <cairo-gobject.h>
+//
+// static cairo_path_data_type_t
+// _cairo_path_data_type(cairo_path_data_t *data, int i)
+// {
+// 	return (data[i].header.type);
+// }
+//
+// static int
+// _cairo_path_data_length(cairo_path_data_t *data, int i)
+// {
+// 	return (data[i].header.length);
+// }
+//
+// static double
+// _cairo_path_data_x(cairo_path_data_t *data, int i)
+// {
+// 	return (data[i].point.x);
+// }
+//
+// static double
+// _cairo_path_data_y(cairo_path_data_t *data, int i)
+// {
+// 	return (data[i].point.y);
+// }
+//
+// static cairo_path_t *
+// _cairo_path_new(int num_data)
+// {
+// 	cairo_path_t *path;
+//
+// 	path = malloc(sizeof(cairo_path_t));
+// 	if (path == NULL)
+// 		return (NULL);
+// 	path->status = CAIRO_STATUS_SUCCESS;
+// 	path->data = calloc(num_data, sizeof(cairo_path_data_t));
+// 	path->num_data = num_data;
+// 	if (path->data == NULL && num_data > 0) {
+// 		free(path);
+// 		return (NULL);
+// 	}
+// 	return (path);
+// }
+//
+// static void
+// _cairo_path_free(cairo_path_t *path)
+// {
+// 	free(path->data);
+// 	free(path);
+// }
+//
+// static void
+// _cairo_path_data_set_header(cairo_path_data_t *data, int i,
+//     cairo_path_data_type_t type, int length)
+// {
+// 	data[i].header.type = type;
+// 	data[i].header.length = length;
+// }
+//
+// static void
+// _cairo_path_data_set_point(cairo_path_data_t *data, int i, double x,
+//     double y)
+// {
+// 	data[i].point.x = x;
+// 	data[i].point.y = y;
+// }
+import "C"
+import (
+	"unsafe"
+
+	"github.com/conformal/gotk3/glib"
+)
+
+func init() {
+	tm := []glib.TypeMarshaler{
+		// Enums
+		{glib.Type(C.cairo_gobject_path_data_type_get_type()), marshalPathDataType},
+	}
+	glib.RegisterGValueMarshalers(tm)
+}
+
+// PathDataType is a representation of Cairo's cairo_path_data_type_t.
+type PathDataType int
+
+const (
+	PATH_MOVE_TO    PathDataType = C.CAIRO_PATH_MOVE_TO
+	PATH_LINE_TO    PathDataType = C.CAIRO_PATH_LINE_TO
+	PATH_CURVE_TO   PathDataType = C.CAIRO_PATH_CURVE_TO
+	PATH_CLOSE_PATH PathDataType = C.CAIRO_PATH_CLOSE_PATH
+)
+
+func marshalPathDataType(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return PathDataType(c), nil
+}
+
+/*
+ * cairo_path_t
+ */
+
+// Point is a point in user space.
+type Point struct {
+	X, Y float64
+}
+
+// PathSegment is a single element of a Path.  MOVE_TO and LINE_TO
+// segments have one point, CURVE_TO segments have the two control points
+// and end point of the curve, and CLOSE_PATH segments have no points.
+type PathSegment struct {
+	Type   PathDataType
+	Points []Point
+}
+
+// Path is a Go representation of Cairo's cairo_path_t.  Unlike
+// cairo_path_t, a Path is not tied to the Context it was copied from and
+// may be freely modified and appended to other contexts.
+type Path []PathSegment
+
+// pathFromC converts and destroys a cairo_path_t.
+func pathFromC(p *C.cairo_path_t) (Path, error) {
+	defer C.cairo_path_destroy(p)
+	if err := statusError(p.status); err != nil {
+		return nil, err
+	}
+	var path Path
+	for i := 0; i < int(p.num_data); {
+		typ := C._cairo_path_data_type(p.data, C.int(i))
+		length := int(C._cairo_path_data_length(p.data, C.int(i)))
+		seg := PathSegment{Type: PathDataType(typ)}
+		for j := i + 1; j < i+length; j++ {
+			seg.Points = append(seg.Points, Point{
+				float64(C._cairo_path_data_x(p.data, C.int(j))),
+				float64(C._cairo_path_data_y(p.data, C.int(j))),
+			})
+		}
+		path = append(path, seg)
+		i += length
+	}
+	return path, nil
+}
+
+// CopyPath is a wrapper around cairo_copy_path().
+func (v *Context) CopyPath() (Path, error) {
+	c := C.cairo_copy_path(v.native())
+	return pathFromC(c)
+}
+
+// CopyPathFlat is a wrapper around cairo_copy_path_flat().  Curves in the
+// current path are approximated by line segments within the current
+// tolerance, so the returned path has no CURVE_TO segments.
+func (v *Context) CopyPathFlat() (Path, error) {
+	c := C.cairo_copy_path_flat(v.native())
+	return pathFromC(c)
+}
+
+// pathDataPoints returns the number of points of a path segment of type
+// typ, or -1 if typ is not a valid PathDataType.
+func pathDataPoints(typ PathDataType) int {
+	switch typ {
+	case PATH_MOVE_TO, PATH_LINE_TO:
+		return 1
+	case PATH_CURVE_TO:
+		return 3
+	case PATH_CLOSE_PATH:
+		return 0
+	}
+	return -1
+}
+
+// setPathError puts the context in an error state with status.  Cairo
+// has no function to set the status of a context, so this appends a
+// path with an error status, which cairo_append_path() copies to the
+// context.
+func (v *Context) setPathError(status C.cairo_status_t) {
+	var p C.cairo_path_t
+	p.status = status
+	C.cairo_append_path(v.native(), &p)
+}
+
+// AppendPath is a wrapper around cairo_append_path().  If a segment has
+// the wrong number of points for its type, nothing is appended and the
+// context is put in an error state with STATUS_INVALID_PATH_DATA.
+func (v *Context) AppendPath(path Path) {
+	n := 0
+	for _, seg := range path {
+		if len(seg.Points) != pathDataPoints(seg.Type) {
+			v.setPathError(C.CAIRO_STATUS_INVALID_PATH_DATA)
+			return
+		}
+		n += 1 + len(seg.Points)
+	}
+	p := C._cairo_path_new(C.int(n))
+	if p == nil {
+		v.setPathError(C.CAIRO_STATUS_NO_MEMORY)
+		return
+	}
+	defer C._cairo_path_free(p)
+	i := 0
+	for _, seg := range path {
+		C._cairo_path_data_set_header(p.data, C.int(i),
+			C.cairo_path_data_type_t(seg.Type),
+			C.int(1+len(seg.Points)))
+		i++
+		for _, pt := range seg.Points {
+			C._cairo_path_data_set_point(p.data, C.int(i),
+				C.double(pt.X), C.double(pt.Y))
+			i++
+		}
+	}
+	C.cairo_append_path(v.native(), p)
+}
+
+// PathExtents is a wrapper around cairo_path_extents().
+func (v *Context) PathExtents() (x1, y1, x2, y2 float64) {
+	var cx1, cy1, cx2, cy2 C.double
+	C.cairo_path_extents(v.native(), &cx1, &cy1, &cx2, &cy2)
+	return float64(cx1), float64(cy1), float64(cx2), float64(cy2)
+}
+
+// HasCurrentPoint is a wrapper around cairo_has_current_point().
+func (v *Context) HasCurrentPoint() bool {
+	c := C.cairo_has_current_point(v.native())
+	return gobool(c)
+}
+
+// RelMoveTo is a wrapper around cairo_rel_move_to().
+func (v *Context) RelMoveTo(dx, dy float64) {
+	C.cairo_rel_move_to(v.native(), C.double(dx), C.double(dy))
+}
+
+// RelLineTo is a wrapper around cairo_rel_line_to().
+func (v *Context) RelLineTo(dx, dy float64) {
+	C.cairo_rel_line_to(v.native(), C.double(dx), C.double(dy))
+}
+
+// RelCurveTo is a wrapper around cairo_rel_curve_to().
+func (v *Context) RelCurveTo(dx1, dy1, dx2, dy2, dx3, dy3 float64) {
+	C.cairo_rel_curve_to(v.native(), C.double(dx1), C.double(dy1),
+		C.double(dx2), C.double(dy2), C.double(dx3), C.double(dy3))
+}
@@ -0,0 +1,302 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package cairo
+
+// #cgo pkg-config: cairo cairo-gobject
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include <cairo-gobject.h>
+import "C"
+import (
+	"runtime"
+	"unsafe"
+
+	"github.com/conformal/gotk3/glib"
+)
+
+func init() {
+	tm := []glib.TypeMarshaler{
+		// Enums
+		{glib.Type(C.cairo_gobject_extend_get_type()), marshalExtend},
+		{glib.Type(C.cairo_gobject_filter_get_type()), marshalFilter},
+		{glib.Type(C.cairo_gobject_pattern_type_get_type()), marshalPatternType},
+
+		// Boxed
+		{glib.Type(C.cairo_gobject_pattern_get_type()), marshalPattern},
+	}
+	glib.RegisterGValueMarshalers(tm)
+}
+
+// Extend is a representation of Cairo's cairo_extend_t.
+type Extend int
+
+const (
+	EXTEND_NONE    Extend = C.CAIRO_EXTEND_NONE
+	EXTEND_REPEAT  Extend = C.CAIRO_EXTEND_REPEAT
+	EXTEND_REFLECT Extend = C.CAIRO_EXTEND_REFLECT
+	EXTEND_PAD     Extend = C.CAIRO_EXTEND_PAD
+)
+
+func marshalExtend(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return Extend(c), nil
+}
+
+// Filter is a representation of Cairo's cairo_filter_t.
+type Filter int
+
+const (
+	FILTER_FAST     Filter = C.CAIRO_FILTER_FAST
+	FILTER_GOOD     Filter = C.CAIRO_FILTER_GOOD
+	FILTER_BEST     Filter = C.CAIRO_FILTER_BEST
+	FILTER_NEAREST  Filter = C.CAIRO_FILTER_NEAREST
+	FILTER_BILINEAR Filter = C.CAIRO_FILTER_BILINEAR
+	FILTER_GAUSSIAN Filter = C.CAIRO_FILTER_GAUSSIAN
+)
+
+func marshalFilter(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return Filter(c), nil
+}
+
+// PatternType is a representation of Cairo's cairo_pattern_type_t.
+type PatternType int
+
+const (
+	PATTERN_TYPE_SOLID   PatternType = C.CAIRO_PATTERN_TYPE_SOLID
+	PATTERN_TYPE_SURFACE PatternType = C.CAIRO_PATTERN_TYPE_SURFACE
+	PATTERN_TYPE_LINEAR  PatternType = C.CAIRO_PATTERN_TYPE_LINEAR
+	PATTERN_TYPE_RADIAL  PatternType = C.CAIRO_PATTERN_TYPE_RADIAL
+)
+
+func marshalPatternType(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return PatternType(c), nil
+}
+
+/*
+ * cairo_pattern_t
+ */
+
+// Pattern is a representation of Cairo's cairo_pattern_t, the source or
+// mask of drawing operations.
+type Pattern struct {
+	pattern *C.cairo_pattern_t
+}
+
+// native returns a pointer to the underlying cairo_pattern_t.
+func (v *Pattern) native() *C.cairo_pattern_t {
+	if v == nil {
+		return nil
+	}
+	return v.pattern
+}
+
+// Native returns a pointer to the underlying cairo_pattern_t.
+func (v *Pattern) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalPattern(p uintptr) (interface{}, error) {
+	c := C.g_value_get_boxed((*C.GValue)(unsafe.Pointer(p)))
+	pattern := (*C.cairo_pattern_t)(unsafe.Pointer(c))
+	return wrapPattern(pattern), nil
+}
+
+func wrapPattern(pattern *C.cairo_pattern_t) *Pattern {
+	return &Pattern{pattern}
+}
+
+// takePattern wraps a newly-created pattern.
+func takePattern(pattern *C.cairo_pattern_t) *Pattern {
+	p := wrapPattern(pattern)
+	runtime.SetFinalizer(p, (*Pattern).destroy)
+	return p
+}
+
+// refPattern wraps a pattern owned by Cairo, taking a new reference.
+func refPattern(pattern *C.cairo_pattern_t) *Pattern {
+	p := wrapPattern(pattern)
+	p.reference()
+	runtime.SetFinalizer(p, (*Pattern).destroy)
+	return p
+}
+
+// PatternCreateRGB is a wrapper around cairo_pattern_create_rgb().
+func PatternCreateRGB(red, green, blue float64) *Pattern {
+	c := C.cairo_pattern_create_rgb(C.double(red), C.double(green),
+		C.double(blue))
+	return takePattern(c)
+}
+
+// PatternCreateRGBA is a wrapper around cairo_pattern_create_rgba().
+func PatternCreateRGBA(red, green, blue, alpha float64) *Pattern {
+	c := C.cairo_pattern_create_rgba(C.double(red), C.double(green),
+		C.double(blue), C.double(alpha))
+	return takePattern(c)
+}
+
+// PatternCreateForSurface is a wrapper around
+// cairo_pattern_create_for_surface().
+func PatternCreateForSurface(surface *Surface) *Pattern {
+	c := C.cairo_pattern_create_for_surface(surface.native())
+	return takePattern(c)
+}
+
+// PatternCreateLinear is a wrapper around cairo_pattern_create_linear().
+// Color stops are added with AddColorStopRGB and AddColorStopRGBA.
+func PatternCreateLinear(x0, y0, x1, y1 float64) *Pattern {
+	c := C.cairo_pattern_create_linear(C.double(x0), C.double(y0),
+		C.double(x1), C.double(y1))
+	return takePattern(c)
+}
+
+// PatternCreateRadial is a wrapper around cairo_pattern_create_radial().
+// Color stops are added with AddColorStopRGB and AddColorStopRGBA.
+func PatternCreateRadial(cx0, cy0, radius0, cx1, cy1, radius1 float64) *Pattern {
+	c := C.cairo_pattern_create_radial(C.double(cx0), C.double(cy0),
+		C.double(radius0), C.double(cx1), C.double(cy1),
+		C.double(radius1))
+	return takePattern(c)
+}
+
+// reference is a wrapper around cairo_pattern_reference().
+func (v *Pattern) reference() {
+	v.pattern = C.cairo_pattern_reference(v.native())
+}
+
+// destroy is a wrapper around cairo_pattern_destroy().
+func (v *Pattern) destroy() {
+	C.cairo_pattern_destroy(v.native())
+}
+
+// Status is a wrapper around cairo_pattern_status().
+func (v *Pattern) Status() Status {
+	c := C.cairo_pattern_status(v.native())
+	return Status(c)
+}
+
+// GetType is a wrapper around cairo_pattern_get_type().
+func (v *Pattern) GetType() PatternType {
+	c := C.cairo_pattern_get_type(v.native())
+	return PatternType(c)
+}
+
+// AddColorStopRGB is a wrapper around cairo_pattern_add_color_stop_rgb().
+func (v *Pattern) AddColorStopRGB(offset, red, green, blue float64) {
+	C.cairo_pattern_add_color_stop_rgb(v.native(), C.double(offset),
+		C.double(red), C.double(green), C.double(blue))
+}
+
+// AddColorStopRGBA is a wrapper around cairo_pattern_add_color_stop_rgba().
+func (v *Pattern) AddColorStopRGBA(offset, red, green, blue, alpha float64) {
+	C.cairo_pattern_add_color_stop_rgba(v.native(), C.double(offset),
+		C.double(red), C.double(green), C.double(blue), C.double(alpha))
+}
+
+// GetColorStopCount is a wrapper around
+// cairo_pattern_get_color_stop_count().  An error is returned if the
+// pattern is not a gradient.
+func (v *Pattern) GetColorStopCount() (int, error) {
+	var count C.int
+	c := C.cairo_pattern_get_color_stop_count(v.native(), &count)
+	return int(count), statusError(c)
+}
+
+// GetColorStopRGBA is a wrapper around cairo_pattern_get_color_stop_rgba().
+func (v *Pattern) GetColorStopRGBA(index int) (offset, red, green, blue, alpha float64, err error) {
+	var o, r, g, b, a C.double
+	c := C.cairo_pattern_get_color_stop_rgba(v.native(), C.int(index),
+		&o, &r, &g, &b, &a)
+	return float64(o), float64(r), float64(g), float64(b), float64(a),
+		statusError(c)
+}
+
+// GetRGBA is a wrapper around cairo_pattern_get_rgba().  An error is
+// returned if the pattern is not a solid color.
+func (v *Pattern) GetRGBA() (red, green, blue, alpha float64, err error) {
+	var r, g, b, a C.double
+	c := C.cairo_pattern_get_rgba(v.native(), &r, &g, &b, &a)
+	return float64(r), float64(g), float64(b), float64(a), statusError(c)
+}
+
+// GetSurface is a wrapper around cairo_pattern_get_surface().  An error
+// is returned if the pattern is not a surface pattern.
+func (v *Pattern) GetSurface() (*Surface, error) {
+	var surface *C.cairo_surface_t
+	c := C.cairo_pattern_get_surface(v.native(), &surface)
+	if err := statusError(c); err != nil {
+		return nil, err
+	}
+	s := wrapSurface(surface)
+	s.reference()
+	runtime.SetFinalizer(s, (*Surface).destroy)
+	return s, nil
+}
+
+// GetLinearPoints is a wrapper around cairo_pattern_get_linear_points().
+func (v *Pattern) GetLinearPoints() (x0, y0, x1, y1 float64, err error) {
+	var cx0, cy0, cx1, cy1 C.double
+	c := C.cairo_pattern_get_linear_points(v.native(), &cx0, &cy0,
+		&cx1, &cy1)
+	return float64(cx0), float64(cy0), float64(cx1), float64(cy1),
+		statusError(c)
+}
+
+// GetRadialCircles is a wrapper around cairo_pattern_get_radial_circles().
+func (v *Pattern) GetRadialCircles() (x0, y0, r0, x1, y1, r1 float64, err error) {
+	var cx0, cy0, cr0, cx1, cy1, cr1 C.double
+	c := C.cairo_pattern_get_radial_circles(v.native(), &cx0, &cy0, &cr0,
+		&cx1, &cy1, &cr1)
+	return float64(cx0), float64(cy0), float64(cr0), float64(cx1),
+		float64(cy1), float64(cr1), statusError(c)
+}
+
+// SetExtend is a wrapper around cairo_pattern_set_extend().
+func (v *Pattern) SetExtend(extend Extend) {
+	C.cairo_pattern_set_extend(v.native(), C.cairo_extend_t(extend))
+}
+
+// GetExtend is a wrapper around cairo_pattern_get_extend().
+func (v *Pattern) GetExtend() Extend {
+	c := C.cairo_pattern_get_extend(v.native())
+	return Extend(c)
+}
+
+// SetFilter is a wrapper around cairo_pattern_set_filter().
+func (v *Pattern) SetFilter(filter Filter) {
+	C.cairo_pattern_set_filter(v.native(), C.cairo_filter_t(filter))
+}
+
+// GetFilter is a wrapper around cairo_pattern_get_filter().
+func (v *Pattern) GetFilter() Filter {
+	c := C.cairo_pattern_get_filter(v.native())
+	return Filter(c)
+}
+
+// SetMatrix is a wrapper around cairo_pattern_set_matrix().  The matrix
+// maps user space to pattern space.
+func (v *Pattern) SetMatrix(matrix *Matrix) {
+	C.cairo_pattern_set_matrix(v.native(), matrix.native())
+}
+
+// GetMatrix is a wrapper around cairo_pattern_get_matrix().
+func (v *Pattern) GetMatrix() *Matrix {
+	var matrix Matrix
+	C.cairo_pattern_get_matrix(v.native(), matrix.native())
+	return &matrix
+}
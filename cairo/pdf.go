@@ -0,0 +1,126 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package cairo
+
+// #cgo pkg-config: cairo cairo-gobject cairo-pdf
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include <cairo-gobject.h>
+// #include <cairo-pdf.h>
+// #include "cairo.go.h"
+//
+// static cairo_surface_t *
+// _cairo_pdf_surface_create_for_stream(void *closure, double width,
+//     double height)
+// {
+// 	return (cairo_pdf_surface_create_for_stream(
+// 	    (cairo_write_func_t)goWriteFunc, closure, width, height));
+// }
+import "C"
+import (
+	"io"
+	"reflect"
+	"unsafe"
+)
+
+// PDFVersion is a representation of Cairo's cairo_pdf_version_t.
+type PDFVersion int
+
+const (
+	PDF_VERSION_1_4 PDFVersion = C.CAIRO_PDF_VERSION_1_4
+	PDF_VERSION_1_5 PDFVersion = C.CAIRO_PDF_VERSION_1_5
+)
+
+// PDFGetVersions is a wrapper around cairo_pdf_get_versions().
+func PDFGetVersions() []PDFVersion {
+	var cversions *C.cairo_pdf_version_t
+	var n C.int
+	C.cairo_pdf_get_versions(&cversions, &n)
+	var versions []C.cairo_pdf_version_t
+	header := (*reflect.SliceHeader)(unsafe.Pointer(&versions))
+	header.Data = uintptr(unsafe.Pointer(cversions))
+	header.Len = int(n)
+	header.Cap = int(n)
+	s := make([]PDFVersion, 0, len(versions))
+	for _, v := range versions {
+		s = append(s, PDFVersion(v))
+	}
+	return s
+}
+
+// String is a wrapper around cairo_pdf_version_to_string().
+func (v PDFVersion) String() string {
+	c := C.cairo_pdf_version_to_string(C.cairo_pdf_version_t(v))
+	if c == nil {
+		return ""
+	}
+	return C.GoString(c)
+}
+
+/*
+ * PDF surface
+ */
+
+// PDFSurface is a representation of a Cairo PDF surface.  Sizes are given
+// in points (1/72 inch).  Each page is emitted by calling ShowPage or
+// CopyPage on a Context drawing to the surface, and the document is
+// complete once the surface is finished.
+type PDFSurface struct {
+	*Surface
+}
+
+// PDFSurfaceCreate is a wrapper around cairo_pdf_surface_create().
+func PDFSurfaceCreate(fileName string, widthInPoints, heightInPoints float64) (*PDFSurface, error) {
+	cstr := C.CString(fileName)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.cairo_pdf_surface_create(cstr, C.double(widthInPoints),
+		C.double(heightInPoints))
+	s, err := takeSurface(c)
+	if err != nil {
+		return nil, err
+	}
+	return &PDFSurface{s}, nil
+}
+
+// PDFSurfaceCreateForStream is a wrapper around
+// cairo_pdf_surface_create_for_stream().  The document is written to w.
+func PDFSurfaceCreateForStream(w io.Writer, widthInPoints, heightInPoints float64) (*PDFSurface, error) {
+	s, err := surfaceCreateForStream(w, func(closure unsafe.Pointer) *C.cairo_surface_t {
+		return C._cairo_pdf_surface_create_for_stream(closure,
+			C.double(widthInPoints), C.double(heightInPoints))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &PDFSurface{s}, nil
+}
+
+// RestrictToVersion is a wrapper around
+// cairo_pdf_surface_restrict_to_version().  It should only be called
+// before any drawing operations have been performed on the surface.
+func (v *PDFSurface) RestrictToVersion(version PDFVersion) {
+	C.cairo_pdf_surface_restrict_to_version(v.native(),
+		C.cairo_pdf_version_t(version))
+}
+
+// SetSize is a wrapper around cairo_pdf_surface_set_size().  It changes
+// the size of the following pages, and should be called before any
+// drawing to the next page.
+func (v *PDFSurface) SetSize(widthInPoints, heightInPoints float64) {
+	C.cairo_pdf_surface_set_size(v.native(), C.double(widthInPoints),
+		C.double(heightInPoints))
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package cairo
+
+// #cgo pkg-config: cairo cairo-gobject
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include <cairo-gobject.h>
+// #include "cairo.go.h"
+import "C"
+import (
+	"io"
+	"unsafe"
+)
+
+// WriteToPNG is a wrapper around cairo_surface_write_to_png().
+func (v *Surface) WriteToPNG(fileName string) error {
+	cstr := C.CString(fileName)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.cairo_surface_write_to_png(v.native(), cstr)
+	return statusError(c)
+}
+
+// WriteToPNGStream is a wrapper around cairo_surface_write_to_png_stream().
+// The PNG data is written to w.
+func (v *Surface) WriteToPNGStream(w io.Writer) error {
+	s := &stream{w: w}
+	h := newHandle(s)
+	defer freeHandle(h)
+	c := C._cairo_surface_write_to_png_stream(v.native(), h)
+	return s.streamError(c)
+}
+
+// ImageSurfaceCreateFromPNG is a wrapper around
+// cairo_image_surface_create_from_png().
+func ImageSurfaceCreateFromPNG(fileName string) (*ImageSurface, error) {
+	cstr := C.CString(fileName)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.cairo_image_surface_create_from_png(cstr)
+	return takeImageSurface(c)
+}
+
+// ImageSurfaceCreateFromPNGStream is a wrapper around
+// cairo_image_surface_create_from_png_stream().  The PNG data is read
+// from r.
+func ImageSurfaceCreateFromPNGStream(r io.Reader) (*ImageSurface, error) {
+	st := &stream{r: r}
+	h := newHandle(st)
+	defer freeHandle(h)
+	c := C._cairo_image_surface_create_from_png_stream(h)
+	if st.err != nil {
+		C.cairo_surface_destroy(c)
+		return nil, st.err
+	}
+	return takeImageSurface(c)
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package cairo
+
+// #cgo pkg-config: cairo cairo-gobject cairo-ps
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include <cairo-gobject.h>
+// #include <cairo-ps.h>
+// #include "cairo.go.h"
+//
+// static cairo_surface_t *
+// _cairo_ps_surface_create_for_stream(void *closure, double width,
+//     double height)
+// {
+// 	return (cairo_ps_surface_create_for_stream(
+// 	    (cairo_write_func_t)goWriteFunc, closure, width, height));
+// }
+import "C"
+import (
+	"io"
+	"reflect"
+	"unsafe"
+)
+
+// PSLevel is a representation of Cairo's cairo_ps_level_t.
+type PSLevel int
+
+const (
+	PS_LEVEL_2 PSLevel = C.CAIRO_PS_LEVEL_2
+	PS_LEVEL_3 PSLevel = C.CAIRO_PS_LEVEL_3
+)
+
+// PSGetLevels is a wrapper around cairo_ps_get_levels().
+func PSGetLevels() []PSLevel {
+	var clevels *C.cairo_ps_level_t
+	var n C.int
+	C.cairo_ps_get_levels(&clevels, &n)
+	var levels []C.cairo_ps_level_t
+	header := (*reflect.SliceHeader)(unsafe.Pointer(&levels))
+	header.Data = uintptr(unsafe.Pointer(clevels))
+	header.Len = int(n)
+	header.Cap = int(n)
+	s := make([]PSLevel, 0, len(levels))
+	for _, v := range levels {
+		s = append(s, PSLevel(v))
+	}
+	return s
+}
+
+// String is a wrapper around cairo_ps_level_to_string().
+func (v PSLevel) String() string {
+	c := C.cairo_ps_level_to_string(C.cairo_ps_level_t(v))
+	if c == nil {
+		return ""
+	}
+	return C.GoString(c)
+}
+
+/*
+ * PostScript surface
+ */
+
+// PSSurface is a representation of a Cairo PostScript surface.  Sizes
+// are given in points (1/72 inch), and the document is complete once the
+// surface is finished.
+type PSSurface struct {
+	*Surface
+}
+
+// PSSurfaceCreate is a wrapper around cairo_ps_surface_create().
+func PSSurfaceCreate(fileName string, widthInPoints, heightInPoints float64) (*PSSurface, error) {
+	cstr := C.CString(fileName)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.cairo_ps_surface_create(cstr, C.double(widthInPoints),
+		C.double(heightInPoints))
+	s, err := takeSurface(c)
+	if err != nil {
+		return nil, err
+	}
+	return &PSSurface{s}, nil
+}
+
+// PSSurfaceCreateForStream is a wrapper around
+// cairo_ps_surface_create_for_stream().  The document is written to w.
+func PSSurfaceCreateForStream(w io.Writer, widthInPoints, heightInPoints float64) (*PSSurface, error) {
+	s, err := surfaceCreateForStream(w, func(closure unsafe.Pointer) *C.cairo_surface_t {
+		return C._cairo_ps_surface_create_for_stream(closure,
+			C.double(widthInPoints), C.double(heightInPoints))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &PSSurface{s}, nil
+}
+
+// RestrictToLevel is a wrapper around cairo_ps_surface_restrict_to_level().
+// It should only be called before any drawing operations have been
+// performed on the surface.
+func (v *PSSurface) RestrictToLevel(level PSLevel) {
+	C.cairo_ps_surface_restrict_to_level(v.native(),
+		C.cairo_ps_level_t(level))
+}
+
+// SetEPS is a wrapper around cairo_ps_surface_set_eps().  An
+// Encapsulated PostScript file must contain a single page.
+func (v *PSSurface) SetEPS(eps bool) {
+	C.cairo_ps_surface_set_eps(v.native(), cairobool(eps))
+}
+
+// GetEPS is a wrapper around cairo_ps_surface_get_eps().
+func (v *PSSurface) GetEPS() bool {
+	c := C.cairo_ps_surface_get_eps(v.native())
+	return gobool(c)
+}
+
+// SetSize is a wrapper around cairo_ps_surface_set_size().  It changes
+// the size of the following pages, and should be called before any
+// drawing to the next page.
+func (v *PSSurface) SetSize(widthInPoints, heightInPoints float64) {
+	C.cairo_ps_surface_set_size(v.native(), C.double(widthInPoints),
+		C.double(heightInPoints))
+}
+
+// DSCBeginSetup is a wrapper around cairo_ps_surface_dsc_begin_setup().
+func (v *PSSurface) DSCBeginSetup() {
+	C.cairo_ps_surface_dsc_begin_setup(v.native())
+}
+
+// DSCBeginPageSetup is a wrapper around
+// cairo_ps_surface_dsc_begin_page_setup().
+func (v *PSSurface) DSCBeginPageSetup() {
+	C.cairo_ps_surface_dsc_begin_page_setup(v.native())
+}
+
+// DSCComment is a wrapper around cairo_ps_surface_dsc_comment().
+func (v *PSSurface) DSCComment(comment string) {
+	cstr := C.CString(comment)
+	defer C.free(unsafe.Pointer(cstr))
+	C.cairo_ps_surface_dsc_comment(v.native(), cstr)
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package cairo
+
+// #cgo pkg-config: cairo cairo-gobject
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include <cairo-gobject.h>
+import "C"
+
+// Rectangle is a representation of Cairo's cairo_rectangle_t.
+type Rectangle struct {
+	X, Y          float64
+	Width, Height float64
+}
+
+func (v *Rectangle) native() *C.cairo_rectangle_t {
+	if v == nil {
+		return nil
+	}
+	return &C.cairo_rectangle_t{
+		x:      C.double(v.X),
+		y:      C.double(v.Y),
+		width:  C.double(v.Width),
+		height: C.double(v.Height),
+	}
+}
+
+/*
+ * Recording surface
+ */
+
+// RecordingSurface is a representation of a Cairo recording surface,
+// which records drawing operations so they can be replayed onto another
+// surface.  To replay a recording, use its Surface as the source of a
+// Context, with SetSourceSurface or PatternCreateForSurface, and paint.
+// The replay is performed at the target's resolution, so a recording may
+// be scaled without loss of quality.
+type RecordingSurface struct {
+	*Surface
+}
+
+// RecordingSurfaceCreate is a wrapper around
+// cairo_recording_surface_create().  If extents is nil, the recording is
+// unbounded.
+func RecordingSurfaceCreate(content Content, extents *Rectangle) (*RecordingSurface, error) {
+	c := C.cairo_recording_surface_create(C.cairo_content_t(content),
+		extents.native())
+	s, err := takeSurface(c)
+	if err != nil {
+		return nil, err
+	}
+	return &RecordingSurface{s}, nil
+}
+
+// InkExtents is a wrapper around cairo_recording_surface_ink_extents().
+// It returns the bounding box of all recorded drawing operations.
+func (v *RecordingSurface) InkExtents() (x0, y0, width, height float64) {
+	var cx0, cy0, cwidth, cheight C.double
+	C.cairo_recording_surface_ink_extents(v.native(), &cx0, &cy0, &cwidth,
+		&cheight)
+	return float64(cx0), float64(cy0), float64(cwidth), float64(cheight)
+}
@@ -0,0 +1,286 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package cairo
+
+// #cgo pkg-config: cairo cairo-gobject
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include <cairo-gobject.h>
+import "C"
+import (
+	"runtime"
+	"unsafe"
+
+	"github.com/conformal/gotk3/glib"
+)
+
+func init() {
+	tm := []glib.TypeMarshaler{
+		// Enums
+		{glib.Type(C.cairo_gobject_region_overlap_get_type()), marshalRegionOverlap},
+
+		// Boxed
+		{glib.Type(C.cairo_gobject_region_get_type()), marshalRegion},
+	}
+	glib.RegisterGValueMarshalers(tm)
+}
+
+// RegionOverlap is a representation of Cairo's cairo_region_overlap_t.
+type RegionOverlap int
+
+const (
+	REGION_OVERLAP_IN   RegionOverlap = C.CAIRO_REGION_OVERLAP_IN
+	REGION_OVERLAP_OUT  RegionOverlap = C.CAIRO_REGION_OVERLAP_OUT
+	REGION_OVERLAP_PART RegionOverlap = C.CAIRO_REGION_OVERLAP_PART
+)
+
+func marshalRegionOverlap(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return RegionOverlap(c), nil
+}
+
+// RectangleInt is a representation of Cairo's cairo_rectangle_int_t.
+type RectangleInt struct {
+	X, Y          int
+	Width, Height int
+}
+
+func (v *RectangleInt) native() *C.cairo_rectangle_int_t {
+	return &C.cairo_rectangle_int_t{
+		x:      C.int(v.X),
+		y:      C.int(v.Y),
+		width:  C.int(v.Width),
+		height: C.int(v.Height),
+	}
+}
+
+func wrapRectangleInt(rect *C.cairo_rectangle_int_t) RectangleInt {
+	return RectangleInt{int(rect.x), int(rect.y), int(rect.width),
+		int(rect.height)}
+}
+
+/*
+ * cairo_region_t
+ */
+
+// Region is a representation of Cairo's cairo_region_t, a set of
+// integer-aligned rectangles.  Operations modifying a region return a
+// non-nil error only if memory could not be allocated.
+type Region struct {
+	region *C.cairo_region_t
+}
+
+// native returns a pointer to the underlying cairo_region_t.
+func (v *Region) native() *C.cairo_region_t {
+	if v == nil {
+		return nil
+	}
+	return v.region
+}
+
+// Native returns a pointer to the underlying cairo_region_t.
+func (v *Region) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalRegion(p uintptr) (interface{}, error) {
+	c := C.g_value_get_boxed((*C.GValue)(unsafe.Pointer(p)))
+	region := (*C.cairo_region_t)(unsafe.Pointer(c))
+	return NewRegion(uintptr(unsafe.Pointer(region)), true), nil
+}
+
+func wrapRegion(region *C.cairo_region_t) *Region {
+	return &Region{region}
+}
+
+// takeRegion wraps a newly-created region.
+func takeRegion(region *C.cairo_region_t) *Region {
+	r := wrapRegion(region)
+	runtime.SetFinalizer(r, (*Region).destroy)
+	return r
+}
+
+// NewRegion creates a gotk3 cairo Region from a pointer to a C
+// cairo_region_t.  This is primarily designed for use with other gotk3
+// packages and should be avoided by applications.
+func NewRegion(r uintptr, needsRef bool) *Region {
+	region := wrapRegion((*C.cairo_region_t)(unsafe.Pointer(r)))
+	if needsRef {
+		region.reference()
+	}
+	runtime.SetFinalizer(region, (*Region).destroy)
+	return region
+}
+
+// RegionCreate is a wrapper around cairo_region_create().  The region is
+// initially empty.
+func RegionCreate() *Region {
+	c := C.cairo_region_create()
+	return takeRegion(c)
+}
+
+// RegionCreateRectangle is a wrapper around
+// cairo_region_create_rectangle().
+func RegionCreateRectangle(rect *RectangleInt) *Region {
+	c := C.cairo_region_create_rectangle(rect.native())
+	return takeRegion(c)
+}
+
+// RegionCreateRectangles is a wrapper around
+// cairo_region_create_rectangles().  The region is the union of rects.
+func RegionCreateRectangles(rects []RectangleInt) *Region {
+	crects := make([]C.cairo_rectangle_int_t, len(rects))
+	for i := range rects {
+		crects[i] = *rects[i].native()
+	}
+	var p *C.cairo_rectangle_int_t
+	if len(crects) > 0 {
+		p = &crects[0]
+	}
+	c := C.cairo_region_create_rectangles(p, C.int(len(crects)))
+	return takeRegion(c)
+}
+
+// Copy is a wrapper around cairo_region_copy().
+func (v *Region) Copy() *Region {
+	c := C.cairo_region_copy(v.native())
+	return takeRegion(c)
+}
+
+// reference is a wrapper around cairo_region_reference().
+func (v *Region) reference() {
+	v.region = C.cairo_region_reference(v.native())
+}
+
+// destroy is a wrapper around cairo_region_destroy().
+func (v *Region) destroy() {
+	C.cairo_region_destroy(v.native())
+}
+
+// Status is a wrapper around cairo_region_status().
+func (v *Region) Status() Status {
+	c := C.cairo_region_status(v.native())
+	return Status(c)
+}
+
+// GetExtents is a wrapper around cairo_region_get_extents().
+func (v *Region) GetExtents() RectangleInt {
+	var rect C.cairo_rectangle_int_t
+	C.cairo_region_get_extents(v.native(), &rect)
+	return wrapRectangleInt(&rect)
+}
+
+// NumRectangles is a wrapper around cairo_region_num_rectangles().
+func (v *Region) NumRectangles() int {
+	c := C.cairo_region_num_rectangles(v.native())
+	return int(c)
+}
+
+// GetRectangle is a wrapper around cairo_region_get_rectangle().
+func (v *Region) GetRectangle(nth int) RectangleInt {
+	var rect C.cairo_rectangle_int_t
+	C.cairo_region_get_rectangle(v.native(), C.int(nth), &rect)
+	return wrapRectangleInt(&rect)
+}
+
+// Rectangles returns all rectangles of the region, which do not overlap
+// and are sorted by Y and then X.
+func (v *Region) Rectangles() []RectangleInt {
+	rects := make([]RectangleInt, v.NumRectangles())
+	for i := range rects {
+		rects[i] = v.GetRectangle(i)
+	}
+	return rects
+}
+
+// IsEmpty is a wrapper around cairo_region_is_empty().
+func (v *Region) IsEmpty() bool {
+	c := C.cairo_region_is_empty(v.native())
+	return gobool(c)
+}
+
+// ContainsPoint is a wrapper around cairo_region_contains_point().
+func (v *Region) ContainsPoint(x, y int) bool {
+	c := C.cairo_region_contains_point(v.native(), C.int(x), C.int(y))
+	return gobool(c)
+}
+
+// ContainsRectangle is a wrapper around cairo_region_contains_rectangle().
+func (v *Region) ContainsRectangle(rect *RectangleInt) RegionOverlap {
+	c := C.cairo_region_contains_rectangle(v.native(), rect.native())
+	return RegionOverlap(c)
+}
+
+// Equal is a wrapper around cairo_region_equal().
+func (v *Region) Equal(other *Region) bool {
+	c := C.cairo_region_equal(v.native(), other.native())
+	return gobool(c)
+}
+
+// Translate is a wrapper around cairo_region_translate().
+func (v *Region) Translate(dx, dy int) {
+	C.cairo_region_translate(v.native(), C.int(dx), C.int(dy))
+}
+
+// Intersect is a wrapper around cairo_region_intersect().
+func (v *Region) Intersect(other *Region) error {
+	c := C.cairo_region_intersect(v.native(), other.native())
+	return statusError(c)
+}
+
+// IntersectRectangle is a wrapper around
+// cairo_region_intersect_rectangle().
+func (v *Region) IntersectRectangle(rect *RectangleInt) error {
+	c := C.cairo_region_intersect_rectangle(v.native(), rect.native())
+	return statusError(c)
+}
+
+// Subtract is a wrapper around cairo_region_subtract().
+func (v *Region) Subtract(other *Region) error {
+	c := C.cairo_region_subtract(v.native(), other.native())
+	return statusError(c)
+}
+
+// SubtractRectangle is a wrapper around cairo_region_subtract_rectangle().
+func (v *Region) SubtractRectangle(rect *RectangleInt) error {
+	c := C.cairo_region_subtract_rectangle(v.native(), rect.native())
+	return statusError(c)
+}
+
+// Union is a wrapper around cairo_region_union().
+func (v *Region) Union(other *Region) error {
+	c := C.cairo_region_union(v.native(), other.native())
+	return statusError(c)
+}
+
+// UnionRectangle is a wrapper around cairo_region_union_rectangle().
+func (v *Region) UnionRectangle(rect *RectangleInt) error {
+	c := C.cairo_region_union_rectangle(v.native(), rect.native())
+	return statusError(c)
+}
+
+// Xor is a wrapper around cairo_region_xor().
+func (v *Region) Xor(other *Region) error {
+	c := C.cairo_region_xor(v.native(), other.native())
+	return statusError(c)
+}
+
+// XorRectangle is a wrapper around cairo_region_xor_rectangle().
+func (v *Region) XorRectangle(rect *RectangleInt) error {
+	c := C.cairo_region_xor_rectangle(v.native(), rect.native())
+	return statusError(c)
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+// This file includes wrapers for symbols included since Cairo 1.12, and
+// and should not be included in a build intended to target any older Cairo
+// versions.  To target an older build, such as 1.10, use
+// 'go build -tags cairo_1_10'.  Otherwise, if no build tags are used, Cairo
+// 1.16 is assumed and this file is built.
+// +build !cairo_1_10
+
+package cairo
+
+// #cgo pkg-config: cairo cairo-gobject cairo-script
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include <cairo-gobject.h>
+// #include <cairo-script.h>
+// #include "cairo.go.h"
+//
+// static cairo_device_t *
+// _cairo_script_create_for_stream(void *closure)
+// {
+// 	return (cairo_script_create_for_stream(
+// 	    (cairo_write_func_t)goWriteFunc, closure));
+// }
+import "C"
+import (
+	"io"
+	"unsafe"
+)
+
+// ScriptMode is a representation of Cairo's cairo_script_mode_t.
+type ScriptMode int
+
+const (
+	SCRIPT_MODE_ASCII  ScriptMode = C.CAIRO_SCRIPT_MODE_ASCII
+	SCRIPT_MODE_BINARY ScriptMode = C.CAIRO_SCRIPT_MODE_BINARY
+)
+
+/*
+ * CairoScript
+ */
+
+// ScriptDevice is a representation of a CairoScript device, which writes
+// the drawing operations performed on its surfaces as a textual script.
+// The script is complete once the device is finished.
+type ScriptDevice struct {
+	*Device
+}
+
+// ScriptCreate is a wrapper around cairo_script_create().
+func ScriptCreate(fileName string) (*ScriptDevice, error) {
+	cstr := C.CString(fileName)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.cairo_script_create(cstr)
+	d, err := takeDevice(c)
+	if err != nil {
+		return nil, err
+	}
+	return &ScriptDevice{d}, nil
+}
+
+// ScriptCreateForStream is a wrapper around
+// cairo_script_create_for_stream().  The script is written to w.
+func ScriptCreateForStream(w io.Writer) (*ScriptDevice, error) {
+	st := &stream{w: w}
+	h := newHandle(st)
+	c := C._cairo_script_create_for_stream(h)
+	d, err := takeDevice(c)
+	if err != nil {
+		freeHandle(h)
+		return nil, st.streamError(C.cairo_device_status(c))
+	}
+	if err := statusError(C._cairo_device_set_go_data(c, h)); err != nil {
+		freeHandle(h)
+		return nil, err
+	}
+	return &ScriptDevice{d}, nil
+}
+
+// SetMode is a wrapper around cairo_script_set_mode().
+func (v *ScriptDevice) SetMode(mode ScriptMode) {
+	C.cairo_script_set_mode(v.native(), C.cairo_script_mode_t(mode))
+}
+
+// GetMode is a wrapper around cairo_script_get_mode().
+func (v *ScriptDevice) GetMode() ScriptMode {
+	c := C.cairo_script_get_mode(v.native())
+	return ScriptMode(c)
+}
+
+// WriteComment is a wrapper around cairo_script_write_comment().
+func (v *ScriptDevice) WriteComment(comment string) {
+	cstr := C.CString(comment)
+	defer C.free(unsafe.Pointer(cstr))
+	C.cairo_script_write_comment(v.native(), cstr, C.int(len(comment)))
+}
+
+// FromRecordingSurface is a wrapper around
+// cairo_script_from_recording_surface().  It writes the operations
+// recorded by a recording surface to the script.
+func (v *ScriptDevice) FromRecordingSurface(recordingSurface *RecordingSurface) error {
+	c := C.cairo_script_from_recording_surface(v.native(),
+		recordingSurface.native())
+	return statusError(c)
+}
+
+// ScriptSurfaceCreate is a wrapper around cairo_script_surface_create().
+// Drawing to the surface is written to the script.
+func ScriptSurfaceCreate(script *ScriptDevice, content Content, width, height float64) (*Surface, error) {
+	c := C.cairo_script_surface_create(script.native(),
+		C.cairo_content_t(content), C.double(width), C.double(height))
+	return takeSurface(c)
+}
+
+// ScriptSurfaceCreateForTarget is a wrapper around
+// cairo_script_surface_create_for_target().  Drawing to the surface is
+// both written to the script and performed on target.
+func ScriptSurfaceCreateForTarget(script *ScriptDevice, target *Surface) (*Surface, error) {
+	c := C.cairo_script_surface_create_for_target(script.native(),
+		target.native())
+	return takeSurface(c)
+}
@@ -0,0 +1,96 @@
+// +build !cairo_1_10
+
+package cairo_test
+
+import (
+	"bytes"
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/conformal/gotk3/cairo"
+)
+
+func TestScriptSurface(t *testing.T) {
+	var buf bytes.Buffer
+	script, err := cairo.ScriptCreateForStream(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	script.SetMode(cairo.SCRIPT_MODE_ASCII)
+	if script.GetMode() != cairo.SCRIPT_MODE_ASCII {
+		t.Error("Script mode not set")
+	}
+	script.WriteComment("gotk3 trace")
+
+	s, err := cairo.ScriptSurfaceCreate(script, cairo.CONTENT_COLOR_ALPHA, 10, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.GetDevice() == nil {
+		t.Error("Script surface has no device")
+	}
+	ctx := newContext(t, s)
+	ctx.SetSourceRGB(1, 0, 0)
+	ctx.Rectangle(1, 1, 5, 5)
+	ctx.Fill()
+	if err := s.Finish(); err != nil {
+		t.Fatal(err)
+	}
+	if err := script.Finish(); err != nil {
+		t.Fatal(err)
+	}
+
+	trace := buf.String()
+	if !strings.HasPrefix(trace, "%!CairoScript") {
+		t.Error("Output is not a CairoScript")
+	}
+	for _, op := range []string{"gotk3 trace", "set-source", "fill"} {
+		if !strings.Contains(trace, op) {
+			t.Errorf("Script does not contain %q", op)
+		}
+	}
+}
+
+func TestScriptWriteError(t *testing.T) {
+	script, err := cairo.ScriptCreateForStream(failingWriter{})
+	if err == nil {
+		script.WriteComment("gotk3 trace")
+		s, err := cairo.ScriptSurfaceCreate(script, cairo.CONTENT_COLOR_ALPHA, 10, 10)
+		if err == nil {
+			ctx := newContext(t, s)
+			ctx.Paint()
+			s.Finish()
+		}
+		err = script.Finish()
+	}
+	if err != errWrite {
+		t.Errorf("Expected writer error, got %v", err)
+	}
+}
+
+func TestScriptSurfaceForTarget(t *testing.T) {
+	var buf bytes.Buffer
+	script, err := cairo.ScriptCreateForStream(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	target := newImageSurface(t, 4, 4)
+	s, err := cairo.ScriptSurfaceCreateForTarget(script, target.Surface)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := newContext(t, s)
+	ctx.SetSourceRGB(0, 0, 1)
+	ctx.Paint()
+	s.Finish()
+	script.Finish()
+
+	img, _ := target.RGBA()
+	if c := img.RGBAAt(2, 2); c != (color.RGBA{0, 0, 0xff, 0xff}) {
+		t.Errorf("Target pixel is %v", c)
+	}
+	if !strings.Contains(buf.String(), "paint") {
+		t.Error("Script does not contain paint")
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package cairo
+
+// #cgo pkg-config: cairo cairo-gobject
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include <cairo-gobject.h>
+// #include "cairo.go.h"
+import "C"
+import (
+	"io"
+	"reflect"
+	"unsafe"
+)
+
+// stream is the Go value saved as the closure of Cairo read and write
+// functions.  The first I/O error is saved so it can be returned in place
+// of Cairo's less descriptive status.
+type stream struct {
+	r   io.Reader
+	w   io.Writer
+	err error
+}
+
+// streamError returns the I/O error saved in a stream, or else an error
+// for status.
+func (v *stream) streamError(status C.cairo_status_t) error {
+	if v.err != nil {
+		return v.err
+	}
+	return statusError(status)
+}
+
+// goWriteFunc is the cairo_write_func_t for streams writing to an
+// io.Writer.
+//
+//export goWriteFunc
+func goWriteFunc(closure unsafe.Pointer, data *C.uchar, length C.uint) C.cairo_status_t {
+	s, ok := getHandle(closure).(*stream)
+	if !ok || s.err != nil {
+		return C.CAIRO_STATUS_WRITE_ERROR
+	}
+	if _, s.err = s.w.Write(C.GoBytes(unsafe.Pointer(data), C.int(length))); s.err != nil {
+		return C.CAIRO_STATUS_WRITE_ERROR
+	}
+	return C.CAIRO_STATUS_SUCCESS
+}
+
+// goReadFunc is the cairo_read_func_t for streams reading from an
+// io.Reader.  Cairo expects length bytes to be read.
+//
+//export goReadFunc
+func goReadFunc(closure unsafe.Pointer, data *C.uchar, length C.uint) C.cairo_status_t {
+	s, ok := getHandle(closure).(*stream)
+	if !ok || s.err != nil {
+		return C.CAIRO_STATUS_READ_ERROR
+	}
+	var buf []byte
+	header := (*reflect.SliceHeader)(unsafe.Pointer(&buf))
+	header.Data = uintptr(unsafe.Pointer(data))
+	header.Len = int(length)
+	header.Cap = int(length)
+	if _, s.err = io.ReadFull(s.r, buf); s.err != nil {
+		return C.CAIRO_STATUS_READ_ERROR
+	}
+	return C.CAIRO_STATUS_SUCCESS
+}
+
+// surfaceCreateForStream creates a surface writing its output to w.  The
+// create func is passed the closure for goWriteFunc, which is kept alive
+// for the lifetime of the created surface.
+func surfaceCreateForStream(w io.Writer, create func(closure unsafe.Pointer) *C.cairo_surface_t) (*Surface, error) {
+	st := &stream{w: w}
+	h := newHandle(st)
+	c := create(h)
+	s, err := takeSurface(c)
+	if err != nil {
+		freeHandle(h)
+		return nil, st.streamError(C.cairo_surface_status(c))
+	}
+	if err := statusError(C._cairo_surface_set_go_data(c, h)); err != nil {
+		freeHandle(h)
+		return nil, err
+	}
+	return s, nil
+}
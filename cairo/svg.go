@@ -0,0 +1,117 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package cairo
+
+// #cgo pkg-config: cairo cairo-gobject cairo-svg
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include <cairo-gobject.h>
+// #include <cairo-svg.h>
+// #include "cairo.go.h"
+//
+// static cairo_surface_t *
+// _cairo_svg_surface_create_for_stream(void *closure, double width,
+//     double height)
+// {
+// 	return (cairo_svg_surface_create_for_stream(
+// 	    (cairo_write_func_t)goWriteFunc, closure, width, height));
+// }
+import "C"
+import (
+	"io"
+	"reflect"
+	"unsafe"
+)
+
+// SVGVersion is a representation of Cairo's cairo_svg_version_t.
+type SVGVersion int
+
+const (
+	SVG_VERSION_1_1 SVGVersion = C.CAIRO_SVG_VERSION_1_1
+	SVG_VERSION_1_2 SVGVersion = C.CAIRO_SVG_VERSION_1_2
+)
+
+// SVGGetVersions is a wrapper around cairo_svg_get_versions().
+func SVGGetVersions() []SVGVersion {
+	var cversions *C.cairo_svg_version_t
+	var n C.int
+	C.cairo_svg_get_versions(&cversions, &n)
+	var versions []C.cairo_svg_version_t
+	header := (*reflect.SliceHeader)(unsafe.Pointer(&versions))
+	header.Data = uintptr(unsafe.Pointer(cversions))
+	header.Len = int(n)
+	header.Cap = int(n)
+	s := make([]SVGVersion, 0, len(versions))
+	for _, v := range versions {
+		s = append(s, SVGVersion(v))
+	}
+	return s
+}
+
+// String is a wrapper around cairo_svg_version_to_string().
+func (v SVGVersion) String() string {
+	c := C.cairo_svg_version_to_string(C.cairo_svg_version_t(v))
+	if c == nil {
+		return ""
+	}
+	return C.GoString(c)
+}
+
+/*
+ * SVG surface
+ */
+
+// SVGSurface is a representation of a Cairo SVG surface.  Sizes are given
+// in points (1/72 inch), and the document is complete once the surface
+// is finished.
+type SVGSurface struct {
+	*Surface
+}
+
+// SVGSurfaceCreate is a wrapper around cairo_svg_surface_create().
+func SVGSurfaceCreate(fileName string, widthInPoints, heightInPoints float64) (*SVGSurface, error) {
+	cstr := C.CString(fileName)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.cairo_svg_surface_create(cstr, C.double(widthInPoints),
+		C.double(heightInPoints))
+	s, err := takeSurface(c)
+	if err != nil {
+		return nil, err
+	}
+	return &SVGSurface{s}, nil
+}
+
+// SVGSurfaceCreateForStream is a wrapper around
+// cairo_svg_surface_create_for_stream().  The document is written to w.
+func SVGSurfaceCreateForStream(w io.Writer, widthInPoints, heightInPoints float64) (*SVGSurface, error) {
+	s, err := surfaceCreateForStream(w, func(closure unsafe.Pointer) *C.cairo_surface_t {
+		return C._cairo_svg_surface_create_for_stream(closure,
+			C.double(widthInPoints), C.double(heightInPoints))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &SVGSurface{s}, nil
+}
+
+// RestrictToVersion is a wrapper around
+// cairo_svg_surface_restrict_to_version().  It should only be called
+// before any drawing operations have been performed on the surface.
+func (v *SVGSurface) RestrictToVersion(version SVGVersion) {
+	C.cairo_svg_surface_restrict_to_version(v.native(),
+		C.cairo_svg_version_t(version))
+}
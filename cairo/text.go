@@ -0,0 +1,212 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package cairo
+
+// #cgo pkg-config: cairo cairo-gobject
+// #include <stdlib.h>
+// #include <cairo.h>
+// #include <cairo-gobject.h>
+import "C"
+import (
+	"reflect"
+	"unsafe"
+
+	"github.com/conformal/gotk3/glib"
+)
+
+func init() {
+	tm := []glib.TypeMarshaler{
+		// Enums
+		{glib.Type(C.cairo_gobject_font_slant_get_type()), marshalFontSlant},
+		{glib.Type(C.cairo_gobject_font_weight_get_type()), marshalFontWeight},
+	}
+	glib.RegisterGValueMarshalers(tm)
+}
+
+// FontSlant is a representation of Cairo's cairo_font_slant_t.
+type FontSlant int
+
+const (
+	FONT_SLANT_NORMAL  FontSlant = C.CAIRO_FONT_SLANT_NORMAL
+	FONT_SLANT_ITALIC  FontSlant = C.CAIRO_FONT_SLANT_ITALIC
+	FONT_SLANT_OBLIQUE FontSlant = C.CAIRO_FONT_SLANT_OBLIQUE
+)
+
+func marshalFontSlant(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return FontSlant(c), nil
+}
+
+// FontWeight is a representation of Cairo's cairo_font_weight_t.
+type FontWeight int
+
+const (
+	FONT_WEIGHT_NORMAL FontWeight = C.CAIRO_FONT_WEIGHT_NORMAL
+	FONT_WEIGHT_BOLD   FontWeight = C.CAIRO_FONT_WEIGHT_BOLD
+)
+
+func marshalFontWeight(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return FontWeight(c), nil
+}
+
+// TextExtents is a representation of Cairo's cairo_text_extents_t.  All
+// values are in user space.
+type TextExtents struct {
+	XBearing float64
+	YBearing float64
+	Width    float64
+	Height   float64
+	XAdvance float64
+	YAdvance float64
+}
+
+// textExtents converts a cairo_text_extents_t.
+func textExtents(extents *C.cairo_text_extents_t) TextExtents {
+	return TextExtents{
+		XBearing: float64(extents.x_bearing),
+		YBearing: float64(extents.y_bearing),
+		Width:    float64(extents.width),
+		Height:   float64(extents.height),
+		XAdvance: float64(extents.x_advance),
+		YAdvance: float64(extents.y_advance),
+	}
+}
+
+// FontExtents is a representation of Cairo's cairo_font_extents_t.  All
+// values are in user space.
+type FontExtents struct {
+	Ascent      float64
+	Descent     float64
+	Height      float64
+	MaxXAdvance float64
+	MaxYAdvance float64
+}
+
+// Glyph is a representation of Cairo's cairo_glyph_t, a glyph index of
+// the current font drawn with its origin at X, Y in user space.
+type Glyph struct {
+	Index uint64
+	X, Y  float64
+}
+
+// cGlyphs copies glyphs to a C array, which must be freed with
+// cairo_glyph_free().
+func cGlyphs(glyphs []Glyph) *C.cairo_glyph_t {
+	if len(glyphs) == 0 {
+		return nil
+	}
+	c := C.cairo_glyph_allocate(C.int(len(glyphs)))
+	var s []C.cairo_glyph_t
+	header := (*reflect.SliceHeader)(unsafe.Pointer(&s))
+	header.Data = uintptr(unsafe.Pointer(c))
+	header.Len = len(glyphs)
+	header.Cap = len(glyphs)
+	for i, g := range glyphs {
+		s[i].index = C.ulong(g.Index)
+		s[i].x = C.double(g.X)
+		s[i].y = C.double(g.Y)
+	}
+	return c
+}
+
+// SelectFontFace is a wrapper around cairo_select_font_face().  This is
+// part of Cairo's toy text API; applications drawing more than simple
+// labels should lay out text with Pango.
+func (v *Context) SelectFontFace(family string, slant FontSlant, weight FontWeight) {
+	cstr := C.CString(family)
+	defer C.free(unsafe.Pointer(cstr))
+	C.cairo_select_font_face(v.native(), cstr, C.cairo_font_slant_t(slant),
+		C.cairo_font_weight_t(weight))
+}
+
+// SetFontSize is a wrapper around cairo_set_font_size().
+func (v *Context) SetFontSize(size float64) {
+	C.cairo_set_font_size(v.native(), C.double(size))
+}
+
+// SetFontMatrix is a wrapper around cairo_set_font_matrix().
+func (v *Context) SetFontMatrix(matrix *Matrix) {
+	C.cairo_set_font_matrix(v.native(), matrix.native())
+}
+
+// GetFontMatrix is a wrapper around cairo_get_font_matrix().
+func (v *Context) GetFontMatrix() *Matrix {
+	var matrix Matrix
+	C.cairo_get_font_matrix(v.native(), matrix.native())
+	return &matrix
+}
+
+// ShowText is a wrapper around cairo_show_text().  The text is drawn with
+// its left edge and baseline at the current point.
+func (v *Context) ShowText(utf8 string) {
+	cstr := C.CString(utf8)
+	defer C.free(unsafe.Pointer(cstr))
+	C.cairo_show_text(v.native(), cstr)
+}
+
+// TextPath is a wrapper around cairo_text_path().
+func (v *Context) TextPath(utf8 string) {
+	cstr := C.CString(utf8)
+	defer C.free(unsafe.Pointer(cstr))
+	C.cairo_text_path(v.native(), cstr)
+}
+
+// TextExtents is a wrapper around cairo_text_extents().
+func (v *Context) TextExtents(utf8 string) TextExtents {
+	cstr := C.CString(utf8)
+	defer C.free(unsafe.Pointer(cstr))
+	var extents C.cairo_text_extents_t
+	C.cairo_text_extents(v.native(), cstr, &extents)
+	return textExtents(&extents)
+}
+
+// FontExtents is a wrapper around cairo_font_extents().
+func (v *Context) FontExtents() FontExtents {
+	var extents C.cairo_font_extents_t
+	C.cairo_font_extents(v.native(), &extents)
+	return FontExtents{
+		Ascent:      float64(extents.ascent),
+		Descent:     float64(extents.descent),
+		Height:      float64(extents.height),
+		MaxXAdvance: float64(extents.max_x_advance),
+		MaxYAdvance: float64(extents.max_y_advance),
+	}
+}
+
+// ShowGlyphs is a wrapper around cairo_show_glyphs().
+func (v *Context) ShowGlyphs(glyphs []Glyph) {
+	c := cGlyphs(glyphs)
+	defer C.cairo_glyph_free(c)
+	C.cairo_show_glyphs(v.native(), c, C.int(len(glyphs)))
+}
+
+// GlyphPath is a wrapper around cairo_glyph_path().
+func (v *Context) GlyphPath(glyphs []Glyph) {
+	c := cGlyphs(glyphs)
+	defer C.cairo_glyph_free(c)
+	C.cairo_glyph_path(v.native(), c, C.int(len(glyphs)))
+}
+
+// GlyphExtents is a wrapper around cairo_glyph_extents().
+func (v *Context) GlyphExtents(glyphs []Glyph) TextExtents {
+	c := cGlyphs(glyphs)
+	defer C.cairo_glyph_free(c)
+	var extents C.cairo_text_extents_t
+	C.cairo_glyph_extents(v.native(), c, C.int(len(glyphs)), &extents)
+	return textExtents(&extents)
+}
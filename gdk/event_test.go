@@ -0,0 +1,249 @@
+package gdk
+
+import "testing"
+
+// newTestEvent creates an event of type t with the fields in f.
+func newTestEvent(t EventType, f eventFields) *Event {
+	e := EventNew(t)
+	setEventFields(e, &f)
+	return e
+}
+
+// pointerFields are the fields set on events with pointer coordinates.
+var pointerFields = eventFields{
+	time:  42,
+	x:     1.5,
+	y:     2.5,
+	xRoot: 101.5,
+	yRoot: 202.5,
+	state: SHIFT_MASK | BUTTON1_MASK,
+}
+
+func TestEventAny(t *testing.T) {
+	e := newTestEvent(EVENT_DELETE, eventFields{sendEvent: true})
+	if e.Type() != EVENT_DELETE {
+		t.Errorf("Type returned %d", e.Type())
+	}
+	a := EventAnyNewFromEvent(e)
+	if !a.SendEvent() {
+		t.Error("SendEvent returned false")
+	}
+	if a.Window() != nil {
+		t.Error("Unset window is not nil")
+	}
+}
+
+func TestEventConversionErrors(t *testing.T) {
+	if _, err := EventButtonNewFromEvent(EventNew(EVENT_SCROLL)); err != eventTypeErr {
+		t.Errorf("Converting scroll event to button event returned %v", err)
+	}
+	if _, err := EventKeyNewFromEvent(EventNew(EVENT_BUTTON_PRESS)); err != eventTypeErr {
+		t.Errorf("Converting button event to key event returned %v", err)
+	}
+	if _, err := EventTouchNewFromEvent(nil); err != nilPtrErr {
+		t.Errorf("Converting nil event returned %v", err)
+	}
+	for _, typ := range []EventType{EVENT_BUTTON_PRESS, EVENT_2BUTTON_PRESS,
+		EVENT_3BUTTON_PRESS, EVENT_BUTTON_RELEASE} {
+		if _, err := EventButtonNewFromEvent(EventNew(typ)); err != nil {
+			t.Errorf("Converting event of type %d returned %v", typ, err)
+		}
+	}
+}
+
+func TestEventKey(t *testing.T) {
+	e := newTestEvent(EVENT_KEY_PRESS, eventFields{
+		time:            7,
+		state:           CONTROL_MASK,
+		keyval:          KEY_Shift_L,
+		hardwareKeycode: 50,
+		group:           1,
+		isModifier:      true,
+	})
+	key, err := EventKeyNewFromEvent(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.Time() != 7 || key.State() != CONTROL_MASK {
+		t.Errorf("Time and state are %d, %#x", key.Time(), key.State())
+	}
+	if key.KeyVal() != KEY_Shift_L {
+		t.Errorf("KeyVal returned %#x", key.KeyVal())
+	}
+	if key.HardwareKeycode() != 50 || key.Group() != 1 {
+		t.Errorf("Keycode and group are %d, %d", key.HardwareKeycode(), key.Group())
+	}
+	if !key.IsModifier() {
+		t.Error("IsModifier returned false")
+	}
+}
+
+func TestEventButton(t *testing.T) {
+	f := pointerFields
+	f.button = 3
+	b, err := EventButtonNewFromEvent(newTestEvent(EVENT_BUTTON_RELEASE, f))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Time() != 42 || b.State() != SHIFT_MASK|BUTTON1_MASK {
+		t.Errorf("Time and state are %d, %#x", b.Time(), b.State())
+	}
+	if b.X() != 1.5 || b.Y() != 2.5 || b.XRoot() != 101.5 || b.YRoot() != 202.5 {
+		t.Errorf("Positions are %v,%v %v,%v", b.X(), b.Y(), b.XRoot(), b.YRoot())
+	}
+	if b.Button() != 3 {
+		t.Errorf("Button returned %d", b.Button())
+	}
+	if b.Window() != nil || b.Device() != nil {
+		t.Error("Unset window or device is not nil")
+	}
+}
+
+func TestEventMotion(t *testing.T) {
+	f := pointerFields
+	f.isHint = true
+	m, err := EventMotionNewFromEvent(newTestEvent(EVENT_MOTION_NOTIFY, f))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Time() != 42 || m.State() != SHIFT_MASK|BUTTON1_MASK {
+		t.Errorf("Time and state are %d, %#x", m.Time(), m.State())
+	}
+	if m.X() != 1.5 || m.Y() != 2.5 || m.XRoot() != 101.5 || m.YRoot() != 202.5 {
+		t.Errorf("Positions are %v,%v %v,%v", m.X(), m.Y(), m.XRoot(), m.YRoot())
+	}
+	if !m.IsHint() {
+		t.Error("IsHint returned false")
+	}
+	if m.Device() != nil {
+		t.Error("Unset device is not nil")
+	}
+}
+
+func TestEventScroll(t *testing.T) {
+	f := pointerFields
+	f.direction = SCROLL_SMOOTH
+	f.deltaX, f.deltaY = -0.25, 1.75
+	s, err := EventScrollNewFromEvent(newTestEvent(EVENT_SCROLL, f))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Time() != 42 || s.State() != SHIFT_MASK|BUTTON1_MASK {
+		t.Errorf("Time and state are %d, %#x", s.Time(), s.State())
+	}
+	if s.X() != 1.5 || s.Y() != 2.5 || s.XRoot() != 101.5 || s.YRoot() != 202.5 {
+		t.Errorf("Positions are %v,%v %v,%v", s.X(), s.Y(), s.XRoot(), s.YRoot())
+	}
+	if s.Direction() != SCROLL_SMOOTH {
+		t.Errorf("Direction returned %d", s.Direction())
+	}
+	if s.DeltaX() != -0.25 || s.DeltaY() != 1.75 {
+		t.Errorf("Deltas are %v,%v", s.DeltaX(), s.DeltaY())
+	}
+}
+
+func TestEventCrossing(t *testing.T) {
+	f := pointerFields
+	f.mode = CROSSING_GRAB
+	f.detail = NOTIFY_INFERIOR
+	f.focus = true
+	c, err := EventCrossingNewFromEvent(newTestEvent(EVENT_LEAVE_NOTIFY, f))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Time() != 42 || c.State() != SHIFT_MASK|BUTTON1_MASK {
+		t.Errorf("Time and state are %d, %#x", c.Time(), c.State())
+	}
+	if c.X() != 1.5 || c.Y() != 2.5 || c.XRoot() != 101.5 || c.YRoot() != 202.5 {
+		t.Errorf("Positions are %v,%v %v,%v", c.X(), c.Y(), c.XRoot(), c.YRoot())
+	}
+	if c.Mode() != CROSSING_GRAB || c.Detail() != NOTIFY_INFERIOR {
+		t.Errorf("Mode and detail are %d, %d", c.Mode(), c.Detail())
+	}
+	if !c.Focus() {
+		t.Error("Focus returned false")
+	}
+	if c.Subwindow() != nil {
+		t.Error("Unset subwindow is not nil")
+	}
+}
+
+func TestEventFocus(t *testing.T) {
+	f, err := EventFocusNewFromEvent(newTestEvent(EVENT_FOCUS_CHANGE, eventFields{in: true}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !f.In() {
+		t.Error("In returned false")
+	}
+	f, err = EventFocusNewFromEvent(newTestEvent(EVENT_FOCUS_CHANGE, eventFields{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.In() {
+		t.Error("In returned true")
+	}
+}
+
+func TestEventConfigure(t *testing.T) {
+	c, err := EventConfigureNewFromEvent(newTestEvent(EVENT_CONFIGURE,
+		eventFields{x: 10, y: 20, width: 300, height: 200}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.X() != 10 || c.Y() != 20 || c.Width() != 300 || c.Height() != 200 {
+		t.Errorf("Geometry is %d,%d %dx%d", c.X(), c.Y(), c.Width(), c.Height())
+	}
+}
+
+func TestEventWindowState(t *testing.T) {
+	w, err := EventWindowStateNewFromEvent(newTestEvent(EVENT_WINDOW_STATE,
+		eventFields{
+			changedMask:    WINDOW_STATE_MAXIMIZED,
+			newWindowState: WINDOW_STATE_MAXIMIZED | WINDOW_STATE_ABOVE,
+		}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.ChangedMask() != WINDOW_STATE_MAXIMIZED {
+		t.Errorf("ChangedMask returned %#x", w.ChangedMask())
+	}
+	if w.NewWindowState() != WINDOW_STATE_MAXIMIZED|WINDOW_STATE_ABOVE {
+		t.Errorf("NewWindowState returned %#x", w.NewWindowState())
+	}
+}
+
+func TestEventTouch(t *testing.T) {
+	f := pointerFields
+	f.emulatingPointer = true
+	touch, err := EventTouchNewFromEvent(newTestEvent(EVENT_TOUCH_UPDATE, f))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if touch.Time() != 42 || touch.State() != SHIFT_MASK|BUTTON1_MASK {
+		t.Errorf("Time and state are %d, %#x", touch.Time(), touch.State())
+	}
+	if touch.X() != 1.5 || touch.Y() != 2.5 || touch.XRoot() != 101.5 || touch.YRoot() != 202.5 {
+		t.Errorf("Positions are %v,%v %v,%v", touch.X(), touch.Y(),
+			touch.XRoot(), touch.YRoot())
+	}
+	if !touch.EmulatingPointer() {
+		t.Error("EmulatingPointer returned false")
+	}
+	if touch.Sequence() != 0 {
+		t.Error("Unset sequence is not 0")
+	}
+}
+
+func TestEventCopy(t *testing.T) {
+	f := pointerFields
+	f.button = 2
+	cp := newTestEvent(EVENT_BUTTON_PRESS, f).Copy()
+	b, err := EventButtonNewFromEvent(cp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Button() != 2 || b.X() != 1.5 {
+		t.Errorf("Copied button event has button %d at %v", b.Button(), b.X())
+	}
+}
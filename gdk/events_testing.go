@@ -0,0 +1,140 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package gdk
+
+// #cgo pkg-config: gdk-3.0
+// #include <gdk/gdk.h>
+//
+// static void
+// _gdk_event_key_set_is_modifier(GdkEventKey *event, gboolean is_modifier)
+// {
+// 	event->is_modifier = is_modifier;
+// }
+import "C"
+
+// This file contains helpers used by the package tests to fill the fields
+// of events created by EventNew.  GDK has setters for few event fields,
+// and these are written through the C structs so that the tests do not
+// depend on their layout.
+
+// eventFields holds the values of the fields of an event.  Fields which
+// are not part of an event type are ignored.
+type eventFields struct {
+	sendEvent        bool
+	time             uint32
+	x, y             float64
+	xRoot, yRoot     float64
+	state            ModifierType
+	keyval           uint
+	hardwareKeycode  uint16
+	group            uint8
+	isModifier       bool
+	button           uint
+	isHint           bool
+	direction        ScrollDirection
+	deltaX, deltaY   float64
+	mode             CrossingMode
+	detail           NotifyType
+	focus            bool
+	in               bool
+	width, height    int
+	changedMask      WindowState
+	newWindowState   WindowState
+	emulatingPointer bool
+}
+
+// setEventFields sets the fields of event to the values in f which are
+// part of its type.
+func setEventFields(event *Event, f *eventFields) {
+	if f.sendEvent {
+		EventAnyNewFromEvent(event).native().send_event = 1
+	}
+
+	switch event.Type() {
+	case EVENT_KEY_PRESS, EVENT_KEY_RELEASE:
+		c := (&EventKey{event}).native()
+		c.time = C.guint32(f.time)
+		c.state = C.guint(f.state)
+		c.keyval = C.guint(f.keyval)
+		c.hardware_keycode = C.guint16(f.hardwareKeycode)
+		c.group = C.guint8(f.group)
+		C._gdk_event_key_set_is_modifier(c, gbool(f.isModifier))
+
+	case EVENT_BUTTON_PRESS, EVENT_2BUTTON_PRESS, EVENT_3BUTTON_PRESS,
+		EVENT_BUTTON_RELEASE:
+		c := (&EventButton{event}).native()
+		c.time = C.guint32(f.time)
+		c.x, c.y = C.gdouble(f.x), C.gdouble(f.y)
+		c.x_root, c.y_root = C.gdouble(f.xRoot), C.gdouble(f.yRoot)
+		c.state = C.guint(f.state)
+		c.button = C.guint(f.button)
+
+	case EVENT_MOTION_NOTIFY:
+		c := (&EventMotion{event}).native()
+		c.time = C.guint32(f.time)
+		c.x, c.y = C.gdouble(f.x), C.gdouble(f.y)
+		c.x_root, c.y_root = C.gdouble(f.xRoot), C.gdouble(f.yRoot)
+		c.state = C.guint(f.state)
+		if f.isHint {
+			c.is_hint = 1
+		}
+
+	case EVENT_SCROLL:
+		c := (&EventScroll{event}).native()
+		c.time = C.guint32(f.time)
+		c.x, c.y = C.gdouble(f.x), C.gdouble(f.y)
+		c.x_root, c.y_root = C.gdouble(f.xRoot), C.gdouble(f.yRoot)
+		c.state = C.guint(f.state)
+		c.direction = C.GdkScrollDirection(f.direction)
+		c.delta_x, c.delta_y = C.gdouble(f.deltaX), C.gdouble(f.deltaY)
+
+	case EVENT_ENTER_NOTIFY, EVENT_LEAVE_NOTIFY:
+		c := (&EventCrossing{event}).native()
+		c.time = C.guint32(f.time)
+		c.x, c.y = C.gdouble(f.x), C.gdouble(f.y)
+		c.x_root, c.y_root = C.gdouble(f.xRoot), C.gdouble(f.yRoot)
+		c.mode = C.GdkCrossingMode(f.mode)
+		c.detail = C.GdkNotifyType(f.detail)
+		c.focus = gbool(f.focus)
+		c.state = C.guint(f.state)
+
+	case EVENT_FOCUS_CHANGE:
+		c := (&EventFocus{event}).native()
+		if f.in {
+			c.in = 1
+		}
+
+	case EVENT_CONFIGURE:
+		c := (&EventConfigure{event}).native()
+		c.x, c.y = C.gint(f.x), C.gint(f.y)
+		c.width, c.height = C.gint(f.width), C.gint(f.height)
+
+	case EVENT_WINDOW_STATE:
+		c := (&EventWindowState{event}).native()
+		c.changed_mask = C.GdkWindowState(f.changedMask)
+		c.new_window_state = C.GdkWindowState(f.newWindowState)
+
+	case EVENT_TOUCH_BEGIN, EVENT_TOUCH_UPDATE, EVENT_TOUCH_END,
+		EVENT_TOUCH_CANCEL:
+		c := (&EventTouch{event}).native()
+		c.time = C.guint32(f.time)
+		c.x, c.y = C.gdouble(f.x), C.gdouble(f.y)
+		c.x_root, c.y_root = C.gdouble(f.xRoot), C.gdouble(f.yRoot)
+		c.state = C.guint(f.state)
+		c.emulating_pointer = gbool(f.emulatingPointer)
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"runtime"
 	"unsafe"
 
+	"github.com/conformal/gotk3/cairo"
 	"github.com/conformal/gotk3/glib"
 )
 
@@ -34,8 +35,15 @@ func init() {
 	tm := []glib.TypeMarshaler{
 		// Enums
 		{glib.Type(C.gdk_colorspace_get_type()), marshalColorspace},
+		{glib.Type(C.gdk_crossing_mode_get_type()), marshalCrossingMode},
+		{glib.Type(C.gdk_event_mask_get_type()), marshalEventMask},
+		{glib.Type(C.gdk_event_type_get_type()), marshalEventType},
 		{glib.Type(C.gdk_interp_type_get_type()), marshalInterpType},
+		{glib.Type(C.gdk_modifier_type_get_type()), marshalModifierType},
+		{glib.Type(C.gdk_notify_type_get_type()), marshalNotifyType},
 		{glib.Type(C.gdk_pixbuf_alpha_mode_get_type()), marshalPixbufAlphaMode},
+		{glib.Type(C.gdk_scroll_direction_get_type()), marshalScrollDirection},
+		{glib.Type(C.gdk_window_state_get_type()), marshalWindowState},
 
 		// Objects/Interfaces
 		{glib.Type(C.gdk_device_get_type()), marshalDevice},
@@ -91,6 +99,115 @@ func marshalColorspace(p uintptr) (interface{}, error) {
 	return Colorspace(c), nil
 }
 
+// CrossingMode is a representation of GDK's GdkCrossingMode.
+type CrossingMode int
+
+const (
+	CROSSING_NORMAL        CrossingMode = C.GDK_CROSSING_NORMAL
+	CROSSING_GRAB          CrossingMode = C.GDK_CROSSING_GRAB
+	CROSSING_UNGRAB        CrossingMode = C.GDK_CROSSING_UNGRAB
+	CROSSING_GTK_GRAB      CrossingMode = C.GDK_CROSSING_GTK_GRAB
+	CROSSING_GTK_UNGRAB    CrossingMode = C.GDK_CROSSING_GTK_UNGRAB
+	CROSSING_STATE_CHANGED CrossingMode = C.GDK_CROSSING_STATE_CHANGED
+	CROSSING_TOUCH_BEGIN   CrossingMode = C.GDK_CROSSING_TOUCH_BEGIN
+	CROSSING_TOUCH_END     CrossingMode = C.GDK_CROSSING_TOUCH_END
+	CROSSING_DEVICE_SWITCH CrossingMode = C.GDK_CROSSING_DEVICE_SWITCH
+)
+
+func marshalCrossingMode(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return CrossingMode(c), nil
+}
+
+// EventMask is a representation of GDK's GdkEventMask, the events a
+// window receives.  A mask may be passed to the SetEvents and AddEvents
+// methods of a GTK widget.
+type EventMask int
+
+const (
+	EXPOSURE_MASK            EventMask = C.GDK_EXPOSURE_MASK
+	POINTER_MOTION_MASK      EventMask = C.GDK_POINTER_MOTION_MASK
+	POINTER_MOTION_HINT_MASK EventMask = C.GDK_POINTER_MOTION_HINT_MASK
+	BUTTON_MOTION_MASK       EventMask = C.GDK_BUTTON_MOTION_MASK
+	BUTTON1_MOTION_MASK      EventMask = C.GDK_BUTTON1_MOTION_MASK
+	BUTTON2_MOTION_MASK      EventMask = C.GDK_BUTTON2_MOTION_MASK
+	BUTTON3_MOTION_MASK      EventMask = C.GDK_BUTTON3_MOTION_MASK
+	BUTTON_PRESS_MASK        EventMask = C.GDK_BUTTON_PRESS_MASK
+	BUTTON_RELEASE_MASK      EventMask = C.GDK_BUTTON_RELEASE_MASK
+	KEY_PRESS_MASK           EventMask = C.GDK_KEY_PRESS_MASK
+	KEY_RELEASE_MASK         EventMask = C.GDK_KEY_RELEASE_MASK
+	ENTER_NOTIFY_MASK        EventMask = C.GDK_ENTER_NOTIFY_MASK
+	LEAVE_NOTIFY_MASK        EventMask = C.GDK_LEAVE_NOTIFY_MASK
+	FOCUS_CHANGE_MASK        EventMask = C.GDK_FOCUS_CHANGE_MASK
+	STRUCTURE_MASK           EventMask = C.GDK_STRUCTURE_MASK
+	PROPERTY_CHANGE_MASK     EventMask = C.GDK_PROPERTY_CHANGE_MASK
+	VISIBILITY_NOTIFY_MASK   EventMask = C.GDK_VISIBILITY_NOTIFY_MASK
+	PROXIMITY_IN_MASK        EventMask = C.GDK_PROXIMITY_IN_MASK
+	PROXIMITY_OUT_MASK       EventMask = C.GDK_PROXIMITY_OUT_MASK
+	SUBSTRUCTURE_MASK        EventMask = C.GDK_SUBSTRUCTURE_MASK
+	SCROLL_MASK              EventMask = C.GDK_SCROLL_MASK
+	TOUCH_MASK               EventMask = C.GDK_TOUCH_MASK
+	SMOOTH_SCROLL_MASK       EventMask = C.GDK_SMOOTH_SCROLL_MASK
+	ALL_EVENTS_MASK          EventMask = C.GDK_ALL_EVENTS_MASK
+)
+
+func marshalEventMask(p uintptr) (interface{}, error) {
+	c := C.g_value_get_flags((*C.GValue)(unsafe.Pointer(p)))
+	return EventMask(c), nil
+}
+
+// EventType is a representation of GDK's GdkEventType.
+type EventType int
+
+const (
+	EVENT_NOTHING           EventType = C.GDK_NOTHING
+	EVENT_DELETE            EventType = C.GDK_DELETE
+	EVENT_DESTROY           EventType = C.GDK_DESTROY
+	EVENT_EXPOSE            EventType = C.GDK_EXPOSE
+	EVENT_MOTION_NOTIFY     EventType = C.GDK_MOTION_NOTIFY
+	EVENT_BUTTON_PRESS      EventType = C.GDK_BUTTON_PRESS
+	EVENT_2BUTTON_PRESS     EventType = C.GDK_2BUTTON_PRESS
+	EVENT_3BUTTON_PRESS     EventType = C.GDK_3BUTTON_PRESS
+	EVENT_BUTTON_RELEASE    EventType = C.GDK_BUTTON_RELEASE
+	EVENT_KEY_PRESS         EventType = C.GDK_KEY_PRESS
+	EVENT_KEY_RELEASE       EventType = C.GDK_KEY_RELEASE
+	EVENT_ENTER_NOTIFY      EventType = C.GDK_ENTER_NOTIFY
+	EVENT_LEAVE_NOTIFY      EventType = C.GDK_LEAVE_NOTIFY
+	EVENT_FOCUS_CHANGE      EventType = C.GDK_FOCUS_CHANGE
+	EVENT_CONFIGURE         EventType = C.GDK_CONFIGURE
+	EVENT_MAP               EventType = C.GDK_MAP
+	EVENT_UNMAP             EventType = C.GDK_UNMAP
+	EVENT_PROPERTY_NOTIFY   EventType = C.GDK_PROPERTY_NOTIFY
+	EVENT_SELECTION_CLEAR   EventType = C.GDK_SELECTION_CLEAR
+	EVENT_SELECTION_REQUEST EventType = C.GDK_SELECTION_REQUEST
+	EVENT_SELECTION_NOTIFY  EventType = C.GDK_SELECTION_NOTIFY
+	EVENT_PROXIMITY_IN      EventType = C.GDK_PROXIMITY_IN
+	EVENT_PROXIMITY_OUT     EventType = C.GDK_PROXIMITY_OUT
+	EVENT_DRAG_ENTER        EventType = C.GDK_DRAG_ENTER
+	EVENT_DRAG_LEAVE        EventType = C.GDK_DRAG_LEAVE
+	EVENT_DRAG_MOTION       EventType = C.GDK_DRAG_MOTION
+	EVENT_DRAG_STATUS       EventType = C.GDK_DRAG_STATUS
+	EVENT_DROP_START        EventType = C.GDK_DROP_START
+	EVENT_DROP_FINISHED     EventType = C.GDK_DROP_FINISHED
+	EVENT_CLIENT_EVENT      EventType = C.GDK_CLIENT_EVENT
+	EVENT_VISIBILITY_NOTIFY EventType = C.GDK_VISIBILITY_NOTIFY
+	EVENT_SCROLL            EventType = C.GDK_SCROLL
+	EVENT_WINDOW_STATE      EventType = C.GDK_WINDOW_STATE
+	EVENT_SETTING           EventType = C.GDK_SETTING
+	EVENT_OWNER_CHANGE      EventType = C.GDK_OWNER_CHANGE
+	EVENT_GRAB_BROKEN       EventType = C.GDK_GRAB_BROKEN
+	EVENT_DAMAGE            EventType = C.GDK_DAMAGE
+	EVENT_TOUCH_BEGIN       EventType = C.GDK_TOUCH_BEGIN
+	EVENT_TOUCH_UPDATE      EventType = C.GDK_TOUCH_UPDATE
+	EVENT_TOUCH_END         EventType = C.GDK_TOUCH_END
+	EVENT_TOUCH_CANCEL      EventType = C.GDK_TOUCH_CANCEL
+)
+
+func marshalEventType(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return EventType(c), nil
+}
+
 // InterpType is a representation of GDK's GdkInterpType.
 type InterpType int
 
@@ -101,6 +218,53 @@ const (
 	INTERP_HYPER    InterpType = C.GDK_INTERP_HYPER
 )
 
+// ModifierType is a representation of GDK's GdkModifierType, the state
+// of modifier keys and mouse buttons.
+type ModifierType uint
+
+const (
+	SHIFT_MASK    ModifierType = C.GDK_SHIFT_MASK
+	LOCK_MASK     ModifierType = C.GDK_LOCK_MASK
+	CONTROL_MASK  ModifierType = C.GDK_CONTROL_MASK
+	MOD1_MASK     ModifierType = C.GDK_MOD1_MASK
+	MOD2_MASK     ModifierType = C.GDK_MOD2_MASK
+	MOD3_MASK     ModifierType = C.GDK_MOD3_MASK
+	MOD4_MASK     ModifierType = C.GDK_MOD4_MASK
+	MOD5_MASK     ModifierType = C.GDK_MOD5_MASK
+	BUTTON1_MASK  ModifierType = C.GDK_BUTTON1_MASK
+	BUTTON2_MASK  ModifierType = C.GDK_BUTTON2_MASK
+	BUTTON3_MASK  ModifierType = C.GDK_BUTTON3_MASK
+	BUTTON4_MASK  ModifierType = C.GDK_BUTTON4_MASK
+	BUTTON5_MASK  ModifierType = C.GDK_BUTTON5_MASK
+	SUPER_MASK    ModifierType = C.GDK_SUPER_MASK
+	HYPER_MASK    ModifierType = C.GDK_HYPER_MASK
+	META_MASK     ModifierType = C.GDK_META_MASK
+	RELEASE_MASK  ModifierType = C.GDK_RELEASE_MASK
+	MODIFIER_MASK ModifierType = C.GDK_MODIFIER_MASK
+)
+
+func marshalModifierType(p uintptr) (interface{}, error) {
+	c := C.g_value_get_flags((*C.GValue)(unsafe.Pointer(p)))
+	return ModifierType(c), nil
+}
+
+// NotifyType is a representation of GDK's GdkNotifyType.
+type NotifyType int
+
+const (
+	NOTIFY_ANCESTOR          NotifyType = C.GDK_NOTIFY_ANCESTOR
+	NOTIFY_VIRTUAL           NotifyType = C.GDK_NOTIFY_VIRTUAL
+	NOTIFY_INFERIOR          NotifyType = C.GDK_NOTIFY_INFERIOR
+	NOTIFY_NONLINEAR         NotifyType = C.GDK_NOTIFY_NONLINEAR
+	NOTIFY_NONLINEAR_VIRTUAL NotifyType = C.GDK_NOTIFY_NONLINEAR_VIRTUAL
+	NOTIFY_UNKNOWN           NotifyType = C.GDK_NOTIFY_UNKNOWN
+)
+
+func marshalNotifyType(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return NotifyType(c), nil
+}
+
 // PixbufRotation is a representation of GDK's GdkPixbufRotation.
 type PixbufRotation int
 
@@ -129,6 +293,42 @@ func marshalPixbufAlphaMode(p uintptr) (interface{}, error) {
 	return PixbufAlphaMode(c), nil
 }
 
+// ScrollDirection is a representation of GDK's GdkScrollDirection.
+type ScrollDirection int
+
+const (
+	SCROLL_UP     ScrollDirection = C.GDK_SCROLL_UP
+	SCROLL_DOWN   ScrollDirection = C.GDK_SCROLL_DOWN
+	SCROLL_LEFT   ScrollDirection = C.GDK_SCROLL_LEFT
+	SCROLL_RIGHT  ScrollDirection = C.GDK_SCROLL_RIGHT
+	SCROLL_SMOOTH ScrollDirection = C.GDK_SCROLL_SMOOTH
+)
+
+func marshalScrollDirection(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return ScrollDirection(c), nil
+}
+
+// WindowState is a representation of GDK's GdkWindowState.
+type WindowState uint
+
+const (
+	WINDOW_STATE_WITHDRAWN  WindowState = C.GDK_WINDOW_STATE_WITHDRAWN
+	WINDOW_STATE_ICONIFIED  WindowState = C.GDK_WINDOW_STATE_ICONIFIED
+	WINDOW_STATE_MAXIMIZED  WindowState = C.GDK_WINDOW_STATE_MAXIMIZED
+	WINDOW_STATE_STICKY     WindowState = C.GDK_WINDOW_STATE_STICKY
+	WINDOW_STATE_FULLSCREEN WindowState = C.GDK_WINDOW_STATE_FULLSCREEN
+	WINDOW_STATE_ABOVE      WindowState = C.GDK_WINDOW_STATE_ABOVE
+	WINDOW_STATE_BELOW      WindowState = C.GDK_WINDOW_STATE_BELOW
+	// WINDOW_STATE_FOCUSED WindowState = C.GDK_WINDOW_STATE_FOCUSED (since 3.10)
+	// WINDOW_STATE_TILED   WindowState = C.GDK_WINDOW_STATE_TILED (since 3.10)
+)
+
+func marshalWindowState(p uintptr) (interface{}, error) {
+	c := C.g_value_get_flags((*C.GValue)(unsafe.Pointer(p)))
+	return WindowState(c), nil
+}
+
 // Selections
 const (
 	SELECTION_PRIMARY       Atom = 1
@@ -523,6 +723,119 @@ func (v *Event) free() {
 	C.gdk_event_free(v.native())
 }
 
+// Copy is a wrapper around gdk_event_copy().  Events passed to signal
+// handlers are only valid for the duration of the handler, and must be
+// copied to be kept.
+func (v *Event) Copy() *Event {
+	c := C.gdk_event_copy(v.native())
+	e := &Event{c}
+	runtime.SetFinalizer(e, (*Event).free)
+	return e
+}
+
+// EventNew is a wrapper around gdk_event_new().  All fields of the new
+// event are zeroed except its type.
+func EventNew(eventType EventType) *Event {
+	c := C.gdk_event_new(C.GdkEventType(eventType))
+	e := &Event{c}
+	runtime.SetFinalizer(e, (*Event).free)
+	return e
+}
+
+// Type returns the type of the event.
+func (v *Event) Type() EventType {
+	c := (*C.GdkEventAny)(unsafe.Pointer(v.native()))._type
+	return EventType(c)
+}
+
+// eventTypeErr is returned when converting an Event to a specific event
+// type which does not match the type of the event.
+var eventTypeErr = errors.New("event does not have the expected type")
+
+// checkEventType returns an error if event is nil or its type is not one
+// of types.
+func checkEventType(event *Event, types ...EventType) error {
+	if event.native() == nil {
+		return nilPtrErr
+	}
+	t := event.Type()
+	for _, typ := range types {
+		if t == typ {
+			return nil
+		}
+	}
+	return eventTypeErr
+}
+
+// TriggersContextMenu is a wrapper around
+// gdk_event_triggers_context_menu().
+func (v *Event) TriggersContextMenu() bool {
+	c := C.gdk_event_triggers_context_menu(v.native())
+	return gobool(c)
+}
+
+// refEventWindow wraps a GdkWindow referenced by an event, returning nil
+// if the window is unset.
+func refEventWindow(c *C.GdkWindow) *Window {
+	if c == nil {
+		return nil
+	}
+	obj := &glib.Object{glib.ToGObject(unsafe.Pointer(c))}
+	w := &Window{obj}
+	obj.Ref()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return w
+}
+
+// refEventDevice wraps a GdkDevice referenced by an event, returning nil
+// if the device is unset.
+func refEventDevice(c *C.GdkDevice) *Device {
+	if c == nil {
+		return nil
+	}
+	obj := &glib.Object{glib.ToGObject(unsafe.Pointer(c))}
+	d := &Device{obj}
+	obj.Ref()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return d
+}
+
+/*
+ * GdkEventAny
+ */
+
+// EventAny is a representation of GDK's GdkEventAny, the fields common
+// to all events.
+type EventAny struct {
+	*Event
+}
+
+// EventAnyNewFromEvent returns an EventAny sharing the GdkEvent of
+// event.
+func EventAnyNewFromEvent(event *Event) *EventAny {
+	return &EventAny{event}
+}
+
+// Native returns a pointer to the underlying GdkEventAny.
+func (v *EventAny) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func (v *EventAny) native() *C.GdkEventAny {
+	return (*C.GdkEventAny)(unsafe.Pointer(v.Event.native()))
+}
+
+// Window returns the window which received the event.
+func (v *EventAny) Window() *Window {
+	return refEventWindow(v.native().window)
+}
+
+// SendEvent returns whether the event was sent explicitly by another
+// client.
+func (v *EventAny) SendEvent() bool {
+	return v.native().send_event != 0
+}
+
 /*
  * GdkEventKey
  */
@@ -532,6 +845,16 @@ type EventKey struct {
 	*Event
 }
 
+// EventKeyNewFromEvent returns an EventKey sharing the GdkEvent of event.
+// An error is returned if event is not a key press or release event.
+func EventKeyNewFromEvent(event *Event) (*EventKey, error) {
+	err := checkEventType(event, EVENT_KEY_PRESS, EVENT_KEY_RELEASE)
+	if err != nil {
+		return nil, err
+	}
+	return &EventKey{event}, nil
+}
+
 // Native returns a pointer to the underlying GdkEventKey.
 func (v *EventKey) Native() uintptr {
 	return uintptr(unsafe.Pointer(v.native()))
@@ -541,11 +864,603 @@ func (v *EventKey) native() *C.GdkEventKey {
 	return (*C.GdkEventKey)(unsafe.Pointer(v.Event.native()))
 }
 
+// KeyVal returns the keyval of the key which was pressed or released.
+// Keyvals are compared with the KEY_ constants.
 func (v *EventKey) KeyVal() uint {
 	c := v.native().keyval
 	return uint(c)
 }
 
+// Window returns the window which received the event.
+func (v *EventKey) Window() *Window {
+	return refEventWindow(v.native().window)
+}
+
+// Time returns the time of the event in milliseconds.
+func (v *EventKey) Time() uint32 {
+	return uint32(v.native().time)
+}
+
+// State returns the state of the modifier keys and mouse buttons before
+// the event.
+func (v *EventKey) State() ModifierType {
+	return ModifierType(v.native().state)
+}
+
+// HardwareKeycode returns the raw code of the key.
+func (v *EventKey) HardwareKeycode() uint16 {
+	return uint16(v.native().hardware_keycode)
+}
+
+// Group returns the keyboard group, or layout, of the event.
+func (v *EventKey) Group() uint8 {
+	return uint8(v.native().group)
+}
+
+// IsModifier returns whether the key is a modifier key, such as Shift or
+// Control.
+func (v *EventKey) IsModifier() bool {
+	c := C._gdk_event_key_is_modifier(v.native())
+	return gobool(c)
+}
+
+/*
+ * GdkEventButton
+ */
+
+// EventButton is a representation of GDK's GdkEventButton, received for
+// the "button-press-event" and "button-release-event" signals.
+// Coordinates are relative to the event's window unless noted otherwise.
+type EventButton struct {
+	*Event
+}
+
+// EventButtonNewFromEvent returns an EventButton sharing the GdkEvent of
+// event.  An error is returned if event is not a button press or release
+// event.
+func EventButtonNewFromEvent(event *Event) (*EventButton, error) {
+	err := checkEventType(event, EVENT_BUTTON_PRESS, EVENT_2BUTTON_PRESS,
+		EVENT_3BUTTON_PRESS, EVENT_BUTTON_RELEASE)
+	if err != nil {
+		return nil, err
+	}
+	return &EventButton{event}, nil
+}
+
+// Native returns a pointer to the underlying GdkEventButton.
+func (v *EventButton) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func (v *EventButton) native() *C.GdkEventButton {
+	return (*C.GdkEventButton)(unsafe.Pointer(v.Event.native()))
+}
+
+// Window returns the window which received the event.
+func (v *EventButton) Window() *Window {
+	return refEventWindow(v.native().window)
+}
+
+// Time returns the time of the event in milliseconds.
+func (v *EventButton) Time() uint32 {
+	return uint32(v.native().time)
+}
+
+// X returns the X coordinate of the pointer.
+func (v *EventButton) X() float64 {
+	return float64(v.native().x)
+}
+
+// Y returns the Y coordinate of the pointer.
+func (v *EventButton) Y() float64 {
+	return float64(v.native().y)
+}
+
+// XRoot returns the X coordinate of the pointer relative to the root of
+// the screen.
+func (v *EventButton) XRoot() float64 {
+	return float64(v.native().x_root)
+}
+
+// YRoot returns the Y coordinate of the pointer relative to the root of
+// the screen.
+func (v *EventButton) YRoot() float64 {
+	return float64(v.native().y_root)
+}
+
+// State returns the state of the modifier keys and mouse buttons before
+// the event.
+func (v *EventButton) State() ModifierType {
+	return ModifierType(v.native().state)
+}
+
+// Button returns the button which was pressed or released, numbered
+// from 1.  Usually 1 is the left button, 2 the middle button and 3 the
+// right button.
+func (v *EventButton) Button() uint {
+	return uint(v.native().button)
+}
+
+// Device returns the device which generated the event.
+func (v *EventButton) Device() *Device {
+	return refEventDevice(v.native().device)
+}
+
+/*
+ * GdkEventMotion
+ */
+
+// EventMotion is a representation of GDK's GdkEventMotion, received for
+// the "motion-notify-event" signal.
+type EventMotion struct {
+	*Event
+}
+
+// EventMotionNewFromEvent returns an EventMotion sharing the GdkEvent of
+// event.  An error is returned if event is not a motion event.
+func EventMotionNewFromEvent(event *Event) (*EventMotion, error) {
+	err := checkEventType(event, EVENT_MOTION_NOTIFY)
+	if err != nil {
+		return nil, err
+	}
+	return &EventMotion{event}, nil
+}
+
+// Native returns a pointer to the underlying GdkEventMotion.
+func (v *EventMotion) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func (v *EventMotion) native() *C.GdkEventMotion {
+	return (*C.GdkEventMotion)(unsafe.Pointer(v.Event.native()))
+}
+
+// Window returns the window which received the event.
+func (v *EventMotion) Window() *Window {
+	return refEventWindow(v.native().window)
+}
+
+// Time returns the time of the event in milliseconds.
+func (v *EventMotion) Time() uint32 {
+	return uint32(v.native().time)
+}
+
+// X returns the X coordinate of the pointer.
+func (v *EventMotion) X() float64 {
+	return float64(v.native().x)
+}
+
+// Y returns the Y coordinate of the pointer.
+func (v *EventMotion) Y() float64 {
+	return float64(v.native().y)
+}
+
+// XRoot returns the X coordinate of the pointer relative to the root of
+// the screen.
+func (v *EventMotion) XRoot() float64 {
+	return float64(v.native().x_root)
+}
+
+// YRoot returns the Y coordinate of the pointer relative to the root of
+// the screen.
+func (v *EventMotion) YRoot() float64 {
+	return float64(v.native().y_root)
+}
+
+// State returns the state of the modifier keys and mouse buttons.
+func (v *EventMotion) State() ModifierType {
+	return ModifierType(v.native().state)
+}
+
+// IsHint returns whether the event is a hint, sent when the window's
+// event mask includes POINTER_MOTION_HINT_MASK.
+func (v *EventMotion) IsHint() bool {
+	return v.native().is_hint != 0
+}
+
+// Device returns the device which generated the event.
+func (v *EventMotion) Device() *Device {
+	return refEventDevice(v.native().device)
+}
+
+/*
+ * GdkEventScroll
+ */
+
+// EventScroll is a representation of GDK's GdkEventScroll, received for
+// the "scroll-event" signal.
+type EventScroll struct {
+	*Event
+}
+
+// EventScrollNewFromEvent returns an EventScroll sharing the GdkEvent of
+// event.  An error is returned if event is not a scroll event.
+func EventScrollNewFromEvent(event *Event) (*EventScroll, error) {
+	err := checkEventType(event, EVENT_SCROLL)
+	if err != nil {
+		return nil, err
+	}
+	return &EventScroll{event}, nil
+}
+
+// Native returns a pointer to the underlying GdkEventScroll.
+func (v *EventScroll) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func (v *EventScroll) native() *C.GdkEventScroll {
+	return (*C.GdkEventScroll)(unsafe.Pointer(v.Event.native()))
+}
+
+// Window returns the window which received the event.
+func (v *EventScroll) Window() *Window {
+	return refEventWindow(v.native().window)
+}
+
+// Time returns the time of the event in milliseconds.
+func (v *EventScroll) Time() uint32 {
+	return uint32(v.native().time)
+}
+
+// X returns the X coordinate of the pointer.
+func (v *EventScroll) X() float64 {
+	return float64(v.native().x)
+}
+
+// Y returns the Y coordinate of the pointer.
+func (v *EventScroll) Y() float64 {
+	return float64(v.native().y)
+}
+
+// XRoot returns the X coordinate of the pointer relative to the root of
+// the screen.
+func (v *EventScroll) XRoot() float64 {
+	return float64(v.native().x_root)
+}
+
+// YRoot returns the Y coordinate of the pointer relative to the root of
+// the screen.
+func (v *EventScroll) YRoot() float64 {
+	return float64(v.native().y_root)
+}
+
+// State returns the state of the modifier keys and mouse buttons.
+func (v *EventScroll) State() ModifierType {
+	return ModifierType(v.native().state)
+}
+
+// Direction returns the direction of the scroll.  Smooth scrolling
+// events, with direction SCROLL_SMOOTH, are only received by windows
+// whose event mask includes SMOOTH_SCROLL_MASK.
+func (v *EventScroll) Direction() ScrollDirection {
+	return ScrollDirection(v.native().direction)
+}
+
+// DeltaX returns the X delta of a smooth scrolling event.
+func (v *EventScroll) DeltaX() float64 {
+	return float64(v.native().delta_x)
+}
+
+// DeltaY returns the Y delta of a smooth scrolling event.
+func (v *EventScroll) DeltaY() float64 {
+	return float64(v.native().delta_y)
+}
+
+// Device returns the device which generated the event.
+func (v *EventScroll) Device() *Device {
+	return refEventDevice(v.native().device)
+}
+
+/*
+ * GdkEventCrossing
+ */
+
+// EventCrossing is a representation of GDK's GdkEventCrossing, received
+// for the "enter-notify-event" and "leave-notify-event" signals.
+type EventCrossing struct {
+	*Event
+}
+
+// EventCrossingNewFromEvent returns an EventCrossing sharing the GdkEvent
+// of event.  An error is returned if event is not an enter or leave event.
+func EventCrossingNewFromEvent(event *Event) (*EventCrossing, error) {
+	err := checkEventType(event, EVENT_ENTER_NOTIFY, EVENT_LEAVE_NOTIFY)
+	if err != nil {
+		return nil, err
+	}
+	return &EventCrossing{event}, nil
+}
+
+// Native returns a pointer to the underlying GdkEventCrossing.
+func (v *EventCrossing) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func (v *EventCrossing) native() *C.GdkEventCrossing {
+	return (*C.GdkEventCrossing)(unsafe.Pointer(v.Event.native()))
+}
+
+// Window returns the window which received the event.
+func (v *EventCrossing) Window() *Window {
+	return refEventWindow(v.native().window)
+}
+
+// Subwindow returns the child window entered or left, or nil.
+func (v *EventCrossing) Subwindow() *Window {
+	return refEventWindow(v.native().subwindow)
+}
+
+// Time returns the time of the event in milliseconds.
+func (v *EventCrossing) Time() uint32 {
+	return uint32(v.native().time)
+}
+
+// X returns the X coordinate of the pointer.
+func (v *EventCrossing) X() float64 {
+	return float64(v.native().x)
+}
+
+// Y returns the Y coordinate of the pointer.
+func (v *EventCrossing) Y() float64 {
+	return float64(v.native().y)
+}
+
+// XRoot returns the X coordinate of the pointer relative to the root of
+// the screen.
+func (v *EventCrossing) XRoot() float64 {
+	return float64(v.native().x_root)
+}
+
+// YRoot returns the Y coordinate of the pointer relative to the root of
+// the screen.
+func (v *EventCrossing) YRoot() float64 {
+	return float64(v.native().y_root)
+}
+
+// Mode returns the crossing mode.
+func (v *EventCrossing) Mode() CrossingMode {
+	return CrossingMode(v.native().mode)
+}
+
+// Detail returns the kind of crossing that occurred.
+func (v *EventCrossing) Detail() NotifyType {
+	return NotifyType(v.native().detail)
+}
+
+// Focus returns whether the window is or contains the focus window.
+func (v *EventCrossing) Focus() bool {
+	return gobool(v.native().focus)
+}
+
+// State returns the state of the modifier keys and mouse buttons.
+func (v *EventCrossing) State() ModifierType {
+	return ModifierType(v.native().state)
+}
+
+/*
+ * GdkEventFocus
+ */
+
+// EventFocus is a representation of GDK's GdkEventFocus, received for the
+// "focus-in-event" and "focus-out-event" signals.
+type EventFocus struct {
+	*Event
+}
+
+// EventFocusNewFromEvent returns an EventFocus sharing the GdkEvent of
+// event.  An error is returned if event is not a focus change event.
+func EventFocusNewFromEvent(event *Event) (*EventFocus, error) {
+	err := checkEventType(event, EVENT_FOCUS_CHANGE)
+	if err != nil {
+		return nil, err
+	}
+	return &EventFocus{event}, nil
+}
+
+// Native returns a pointer to the underlying GdkEventFocus.
+func (v *EventFocus) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func (v *EventFocus) native() *C.GdkEventFocus {
+	return (*C.GdkEventFocus)(unsafe.Pointer(v.Event.native()))
+}
+
+// Window returns the window which received the event.
+func (v *EventFocus) Window() *Window {
+	return refEventWindow(v.native().window)
+}
+
+// In returns true if the window gained focus, or false if it lost focus.
+func (v *EventFocus) In() bool {
+	return v.native().in != 0
+}
+
+/*
+ * GdkEventConfigure
+ */
+
+// EventConfigure is a representation of GDK's GdkEventConfigure,
+// received for the "configure-event" signal when a window's size or
+// position changes.
+type EventConfigure struct {
+	*Event
+}
+
+// EventConfigureNewFromEvent returns an EventConfigure sharing the
+// GdkEvent of event.  An error is returned if event is not a configure
+// event.
+func EventConfigureNewFromEvent(event *Event) (*EventConfigure, error) {
+	err := checkEventType(event, EVENT_CONFIGURE)
+	if err != nil {
+		return nil, err
+	}
+	return &EventConfigure{event}, nil
+}
+
+// Native returns a pointer to the underlying GdkEventConfigure.
+func (v *EventConfigure) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func (v *EventConfigure) native() *C.GdkEventConfigure {
+	return (*C.GdkEventConfigure)(unsafe.Pointer(v.Event.native()))
+}
+
+// Window returns the window which received the event.
+func (v *EventConfigure) Window() *Window {
+	return refEventWindow(v.native().window)
+}
+
+// X returns the new X coordinate of the window, relative to its parent.
+func (v *EventConfigure) X() int {
+	return int(v.native().x)
+}
+
+// Y returns the new Y coordinate of the window, relative to its parent.
+func (v *EventConfigure) Y() int {
+	return int(v.native().y)
+}
+
+// Width returns the new width of the window.
+func (v *EventConfigure) Width() int {
+	return int(v.native().width)
+}
+
+// Height returns the new height of the window.
+func (v *EventConfigure) Height() int {
+	return int(v.native().height)
+}
+
+/*
+ * GdkEventWindowState
+ */
+
+// EventWindowState is a representation of GDK's GdkEventWindowState,
+// received for the "window-state-event" signal.
+type EventWindowState struct {
+	*Event
+}
+
+// EventWindowStateNewFromEvent returns an EventWindowState sharing the
+// GdkEvent of event.  An error is returned if event is not a window state
+// event.
+func EventWindowStateNewFromEvent(event *Event) (*EventWindowState, error) {
+	err := checkEventType(event, EVENT_WINDOW_STATE)
+	if err != nil {
+		return nil, err
+	}
+	return &EventWindowState{event}, nil
+}
+
+// Native returns a pointer to the underlying GdkEventWindowState.
+func (v *EventWindowState) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func (v *EventWindowState) native() *C.GdkEventWindowState {
+	return (*C.GdkEventWindowState)(unsafe.Pointer(v.Event.native()))
+}
+
+// Window returns the window which received the event.
+func (v *EventWindowState) Window() *Window {
+	return refEventWindow(v.native().window)
+}
+
+// ChangedMask returns the flags which changed.
+func (v *EventWindowState) ChangedMask() WindowState {
+	return WindowState(v.native().changed_mask)
+}
+
+// NewWindowState returns the new state of the window.
+func (v *EventWindowState) NewWindowState() WindowState {
+	return WindowState(v.native().new_window_state)
+}
+
+/*
+ * GdkEventTouch
+ */
+
+// EventTouch is a representation of GDK's GdkEventTouch, received for
+// the "touch-event" signal.
+type EventTouch struct {
+	*Event
+}
+
+// EventTouchNewFromEvent returns an EventTouch sharing the GdkEvent of
+// event.  An error is returned if event is not a touch event.
+func EventTouchNewFromEvent(event *Event) (*EventTouch, error) {
+	err := checkEventType(event, EVENT_TOUCH_BEGIN, EVENT_TOUCH_UPDATE,
+		EVENT_TOUCH_END, EVENT_TOUCH_CANCEL)
+	if err != nil {
+		return nil, err
+	}
+	return &EventTouch{event}, nil
+}
+
+// Native returns a pointer to the underlying GdkEventTouch.
+func (v *EventTouch) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func (v *EventTouch) native() *C.GdkEventTouch {
+	return (*C.GdkEventTouch)(unsafe.Pointer(v.Event.native()))
+}
+
+// Window returns the window which received the event.
+func (v *EventTouch) Window() *Window {
+	return refEventWindow(v.native().window)
+}
+
+// Time returns the time of the event in milliseconds.
+func (v *EventTouch) Time() uint32 {
+	return uint32(v.native().time)
+}
+
+// X returns the X coordinate of the touch.
+func (v *EventTouch) X() float64 {
+	return float64(v.native().x)
+}
+
+// Y returns the Y coordinate of the touch.
+func (v *EventTouch) Y() float64 {
+	return float64(v.native().y)
+}
+
+// XRoot returns the X coordinate of the touch relative to the root of
+// the screen.
+func (v *EventTouch) XRoot() float64 {
+	return float64(v.native().x_root)
+}
+
+// YRoot returns the Y coordinate of the touch relative to the root of
+// the screen.
+func (v *EventTouch) YRoot() float64 {
+	return float64(v.native().y_root)
+}
+
+// State returns the state of the modifier keys and mouse buttons.
+func (v *EventTouch) State() ModifierType {
+	return ModifierType(v.native().state)
+}
+
+// Sequence returns an opaque identifier for the touch sequence, the
+// same for all events of one touch.
+func (v *EventTouch) Sequence() uintptr {
+	return uintptr(unsafe.Pointer(v.native().sequence))
+}
+
+// EmulatingPointer returns whether the event is from the touch sequence
+// used to emulate pointer events.
+func (v *EventTouch) EmulatingPointer() bool {
+	return gobool(v.native().emulating_pointer)
+}
+
+// Device returns the device which generated the event.
+func (v *EventTouch) Device() *Device {
+	return refEventDevice(v.native().device)
+}
+
 /*
  * GdkPixbuf
  */
@@ -833,3 +1748,27 @@ func marshalWindow(p uintptr) (interface{}, error) {
 	obj := &glib.Object{glib.ToGObject(unsafe.Pointer(c))}
 	return &Window{obj}, nil
 }
+
+// InvalidateRegion is a wrapper around gdk_window_invalidate_region().
+func (v *Window) InvalidateRegion(region *cairo.Region, invalidateChildren bool) {
+	r := (*C.cairo_region_t)(unsafe.Pointer(region.Native()))
+	C.gdk_window_invalidate_region(v.native(), r, gbool(invalidateChildren))
+}
+
+// ShapeCombineRegion is a wrapper around
+// gdk_window_shape_combine_region().  Passing a nil region removes the
+// shape.
+func (v *Window) ShapeCombineRegion(shapeRegion *cairo.Region, offsetX, offsetY int) {
+	r := (*C.cairo_region_t)(unsafe.Pointer(shapeRegion.Native()))
+	C.gdk_window_shape_combine_region(v.native(), r, C.gint(offsetX),
+		C.gint(offsetY))
+}
+
+// InputShapeCombineRegion is a wrapper around
+// gdk_window_input_shape_combine_region().  Passing a nil region removes
+// the input shape.
+func (v *Window) InputShapeCombineRegion(shapeRegion *cairo.Region, offsetX, offsetY int) {
+	r := (*C.cairo_region_t)(unsafe.Pointer(shapeRegion.Native()))
+	C.gdk_window_input_shape_combine_region(v.native(), r, C.gint(offsetX),
+		C.gint(offsetY))
+}
@@ -66,3 +66,9 @@ toGdkWindow(void *p)
 {
 	return (GDK_WINDOW(p));
 }
+
+static gboolean
+_gdk_event_key_is_modifier(GdkEventKey *event)
+{
+	return (event->is_modifier);
+}
@@ -0,0 +1,352 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package gdk
+
+// #cgo pkg-config: gdk-3.0
+// #include <stdlib.h>
+// #include <gdk/gdk.h>
+import "C"
+import "unsafe"
+
+// Keyvals identify keys independently of the keyboard layout.  The KEY_
+// constants are a representation of GDK's GDK_KEY_ keyval definitions,
+// and cover the most commonly used keys.  Keyvals for other keys may be
+// looked up by name with KeyvalFromName.
+const (
+	// Special and editing keys
+	KEY_VoidSymbol  = C.GDK_KEY_VoidSymbol
+	KEY_BackSpace   = C.GDK_KEY_BackSpace
+	KEY_Tab         = C.GDK_KEY_Tab
+	KEY_Linefeed    = C.GDK_KEY_Linefeed
+	KEY_Clear       = C.GDK_KEY_Clear
+	KEY_Return      = C.GDK_KEY_Return
+	KEY_Pause       = C.GDK_KEY_Pause
+	KEY_Scroll_Lock = C.GDK_KEY_Scroll_Lock
+	KEY_Sys_Req     = C.GDK_KEY_Sys_Req
+	KEY_Escape      = C.GDK_KEY_Escape
+	KEY_Delete      = C.GDK_KEY_Delete
+	KEY_Multi_key   = C.GDK_KEY_Multi_key
+
+	// Cursor control and motion
+	KEY_Home      = C.GDK_KEY_Home
+	KEY_Left      = C.GDK_KEY_Left
+	KEY_Up        = C.GDK_KEY_Up
+	KEY_Right     = C.GDK_KEY_Right
+	KEY_Down      = C.GDK_KEY_Down
+	KEY_Prior     = C.GDK_KEY_Prior
+	KEY_Page_Up   = C.GDK_KEY_Page_Up
+	KEY_Next      = C.GDK_KEY_Next
+	KEY_Page_Down = C.GDK_KEY_Page_Down
+	KEY_End       = C.GDK_KEY_End
+	KEY_Begin     = C.GDK_KEY_Begin
+
+	// Miscellaneous functions
+	KEY_Select      = C.GDK_KEY_Select
+	KEY_Print       = C.GDK_KEY_Print
+	KEY_Execute     = C.GDK_KEY_Execute
+	KEY_Insert      = C.GDK_KEY_Insert
+	KEY_Undo        = C.GDK_KEY_Undo
+	KEY_Redo        = C.GDK_KEY_Redo
+	KEY_Menu        = C.GDK_KEY_Menu
+	KEY_Find        = C.GDK_KEY_Find
+	KEY_Cancel      = C.GDK_KEY_Cancel
+	KEY_Help        = C.GDK_KEY_Help
+	KEY_Break       = C.GDK_KEY_Break
+	KEY_Mode_switch = C.GDK_KEY_Mode_switch
+	KEY_Num_Lock    = C.GDK_KEY_Num_Lock
+
+	// Keypad
+	KEY_KP_Space     = C.GDK_KEY_KP_Space
+	KEY_KP_Tab       = C.GDK_KEY_KP_Tab
+	KEY_KP_Enter     = C.GDK_KEY_KP_Enter
+	KEY_KP_F1        = C.GDK_KEY_KP_F1
+	KEY_KP_F2        = C.GDK_KEY_KP_F2
+	KEY_KP_F3        = C.GDK_KEY_KP_F3
+	KEY_KP_F4        = C.GDK_KEY_KP_F4
+	KEY_KP_Home      = C.GDK_KEY_KP_Home
+	KEY_KP_Left      = C.GDK_KEY_KP_Left
+	KEY_KP_Up        = C.GDK_KEY_KP_Up
+	KEY_KP_Right     = C.GDK_KEY_KP_Right
+	KEY_KP_Down      = C.GDK_KEY_KP_Down
+	KEY_KP_Prior     = C.GDK_KEY_KP_Prior
+	KEY_KP_Page_Up   = C.GDK_KEY_KP_Page_Up
+	KEY_KP_Next      = C.GDK_KEY_KP_Next
+	KEY_KP_Page_Down = C.GDK_KEY_KP_Page_Down
+	KEY_KP_End       = C.GDK_KEY_KP_End
+	KEY_KP_Begin     = C.GDK_KEY_KP_Begin
+	KEY_KP_Insert    = C.GDK_KEY_KP_Insert
+	KEY_KP_Delete    = C.GDK_KEY_KP_Delete
+	KEY_KP_Equal     = C.GDK_KEY_KP_Equal
+	KEY_KP_Multiply  = C.GDK_KEY_KP_Multiply
+	KEY_KP_Add       = C.GDK_KEY_KP_Add
+	KEY_KP_Separator = C.GDK_KEY_KP_Separator
+	KEY_KP_Subtract  = C.GDK_KEY_KP_Subtract
+	KEY_KP_Decimal   = C.GDK_KEY_KP_Decimal
+	KEY_KP_Divide    = C.GDK_KEY_KP_Divide
+	KEY_KP_0         = C.GDK_KEY_KP_0
+	KEY_KP_1         = C.GDK_KEY_KP_1
+	KEY_KP_2         = C.GDK_KEY_KP_2
+	KEY_KP_3         = C.GDK_KEY_KP_3
+	KEY_KP_4         = C.GDK_KEY_KP_4
+	KEY_KP_5         = C.GDK_KEY_KP_5
+	KEY_KP_6         = C.GDK_KEY_KP_6
+	KEY_KP_7         = C.GDK_KEY_KP_7
+	KEY_KP_8         = C.GDK_KEY_KP_8
+	KEY_KP_9         = C.GDK_KEY_KP_9
+
+	// Function keys
+	KEY_F1  = C.GDK_KEY_F1
+	KEY_F2  = C.GDK_KEY_F2
+	KEY_F3  = C.GDK_KEY_F3
+	KEY_F4  = C.GDK_KEY_F4
+	KEY_F5  = C.GDK_KEY_F5
+	KEY_F6  = C.GDK_KEY_F6
+	KEY_F7  = C.GDK_KEY_F7
+	KEY_F8  = C.GDK_KEY_F8
+	KEY_F9  = C.GDK_KEY_F9
+	KEY_F10 = C.GDK_KEY_F10
+	KEY_F11 = C.GDK_KEY_F11
+	KEY_F12 = C.GDK_KEY_F12
+	KEY_F13 = C.GDK_KEY_F13
+	KEY_F14 = C.GDK_KEY_F14
+	KEY_F15 = C.GDK_KEY_F15
+	KEY_F16 = C.GDK_KEY_F16
+	KEY_F17 = C.GDK_KEY_F17
+	KEY_F18 = C.GDK_KEY_F18
+	KEY_F19 = C.GDK_KEY_F19
+	KEY_F20 = C.GDK_KEY_F20
+	KEY_F21 = C.GDK_KEY_F21
+	KEY_F22 = C.GDK_KEY_F22
+	KEY_F23 = C.GDK_KEY_F23
+	KEY_F24 = C.GDK_KEY_F24
+	KEY_F25 = C.GDK_KEY_F25
+	KEY_F26 = C.GDK_KEY_F26
+	KEY_F27 = C.GDK_KEY_F27
+	KEY_F28 = C.GDK_KEY_F28
+	KEY_F29 = C.GDK_KEY_F29
+	KEY_F30 = C.GDK_KEY_F30
+	KEY_F31 = C.GDK_KEY_F31
+	KEY_F32 = C.GDK_KEY_F32
+	KEY_F33 = C.GDK_KEY_F33
+	KEY_F34 = C.GDK_KEY_F34
+	KEY_F35 = C.GDK_KEY_F35
+
+	// Modifiers
+	KEY_Shift_L          = C.GDK_KEY_Shift_L
+	KEY_Shift_R          = C.GDK_KEY_Shift_R
+	KEY_Control_L        = C.GDK_KEY_Control_L
+	KEY_Control_R        = C.GDK_KEY_Control_R
+	KEY_Caps_Lock        = C.GDK_KEY_Caps_Lock
+	KEY_Shift_Lock       = C.GDK_KEY_Shift_Lock
+	KEY_Meta_L           = C.GDK_KEY_Meta_L
+	KEY_Meta_R           = C.GDK_KEY_Meta_R
+	KEY_Alt_L            = C.GDK_KEY_Alt_L
+	KEY_Alt_R            = C.GDK_KEY_Alt_R
+	KEY_Super_L          = C.GDK_KEY_Super_L
+	KEY_Super_R          = C.GDK_KEY_Super_R
+	KEY_Hyper_L          = C.GDK_KEY_Hyper_L
+	KEY_Hyper_R          = C.GDK_KEY_Hyper_R
+	KEY_ISO_Level3_Shift = C.GDK_KEY_ISO_Level3_Shift
+	KEY_ISO_Left_Tab     = C.GDK_KEY_ISO_Left_Tab
+
+	// Latin 1
+	KEY_space        = C.GDK_KEY_space
+	KEY_exclam       = C.GDK_KEY_exclam
+	KEY_quotedbl     = C.GDK_KEY_quotedbl
+	KEY_numbersign   = C.GDK_KEY_numbersign
+	KEY_dollar       = C.GDK_KEY_dollar
+	KEY_percent      = C.GDK_KEY_percent
+	KEY_ampersand    = C.GDK_KEY_ampersand
+	KEY_apostrophe   = C.GDK_KEY_apostrophe
+	KEY_parenleft    = C.GDK_KEY_parenleft
+	KEY_parenright   = C.GDK_KEY_parenright
+	KEY_asterisk     = C.GDK_KEY_asterisk
+	KEY_plus         = C.GDK_KEY_plus
+	KEY_comma        = C.GDK_KEY_comma
+	KEY_minus        = C.GDK_KEY_minus
+	KEY_period       = C.GDK_KEY_period
+	KEY_slash        = C.GDK_KEY_slash
+	KEY_0            = C.GDK_KEY_0
+	KEY_1            = C.GDK_KEY_1
+	KEY_2            = C.GDK_KEY_2
+	KEY_3            = C.GDK_KEY_3
+	KEY_4            = C.GDK_KEY_4
+	KEY_5            = C.GDK_KEY_5
+	KEY_6            = C.GDK_KEY_6
+	KEY_7            = C.GDK_KEY_7
+	KEY_8            = C.GDK_KEY_8
+	KEY_9            = C.GDK_KEY_9
+	KEY_colon        = C.GDK_KEY_colon
+	KEY_semicolon    = C.GDK_KEY_semicolon
+	KEY_less         = C.GDK_KEY_less
+	KEY_equal        = C.GDK_KEY_equal
+	KEY_greater      = C.GDK_KEY_greater
+	KEY_question     = C.GDK_KEY_question
+	KEY_at           = C.GDK_KEY_at
+	KEY_A            = C.GDK_KEY_A
+	KEY_B            = C.GDK_KEY_B
+	KEY_C            = C.GDK_KEY_C
+	KEY_D            = C.GDK_KEY_D
+	KEY_E            = C.GDK_KEY_E
+	KEY_F            = C.GDK_KEY_F
+	KEY_G            = C.GDK_KEY_G
+	KEY_H            = C.GDK_KEY_H
+	KEY_I            = C.GDK_KEY_I
+	KEY_J            = C.GDK_KEY_J
+	KEY_K            = C.GDK_KEY_K
+	KEY_L            = C.GDK_KEY_L
+	KEY_M            = C.GDK_KEY_M
+	KEY_N            = C.GDK_KEY_N
+	KEY_O            = C.GDK_KEY_O
+	KEY_P            = C.GDK_KEY_P
+	KEY_Q            = C.GDK_KEY_Q
+	KEY_R            = C.GDK_KEY_R
+	KEY_S            = C.GDK_KEY_S
+	KEY_T            = C.GDK_KEY_T
+	KEY_U            = C.GDK_KEY_U
+	KEY_V            = C.GDK_KEY_V
+	KEY_W            = C.GDK_KEY_W
+	KEY_X            = C.GDK_KEY_X
+	KEY_Y            = C.GDK_KEY_Y
+	KEY_Z            = C.GDK_KEY_Z
+	KEY_bracketleft  = C.GDK_KEY_bracketleft
+	KEY_backslash    = C.GDK_KEY_backslash
+	KEY_bracketright = C.GDK_KEY_bracketright
+	KEY_asciicircum  = C.GDK_KEY_asciicircum
+	KEY_underscore   = C.GDK_KEY_underscore
+	KEY_grave        = C.GDK_KEY_grave
+	KEY_a            = C.GDK_KEY_a
+	KEY_b            = C.GDK_KEY_b
+	KEY_c            = C.GDK_KEY_c
+	KEY_d            = C.GDK_KEY_d
+	KEY_e            = C.GDK_KEY_e
+	KEY_f            = C.GDK_KEY_f
+	KEY_g            = C.GDK_KEY_g
+	KEY_h            = C.GDK_KEY_h
+	KEY_i            = C.GDK_KEY_i
+	KEY_j            = C.GDK_KEY_j
+	KEY_k            = C.GDK_KEY_k
+	KEY_l            = C.GDK_KEY_l
+	KEY_m            = C.GDK_KEY_m
+	KEY_n            = C.GDK_KEY_n
+	KEY_o            = C.GDK_KEY_o
+	KEY_p            = C.GDK_KEY_p
+	KEY_q            = C.GDK_KEY_q
+	KEY_r            = C.GDK_KEY_r
+	KEY_s            = C.GDK_KEY_s
+	KEY_t            = C.GDK_KEY_t
+	KEY_u            = C.GDK_KEY_u
+	KEY_v            = C.GDK_KEY_v
+	KEY_w            = C.GDK_KEY_w
+	KEY_x            = C.GDK_KEY_x
+	KEY_y            = C.GDK_KEY_y
+	KEY_z            = C.GDK_KEY_z
+	KEY_braceleft    = C.GDK_KEY_braceleft
+	KEY_bar          = C.GDK_KEY_bar
+	KEY_braceright   = C.GDK_KEY_braceright
+	KEY_asciitilde   = C.GDK_KEY_asciitilde
+
+	// Multimedia and internet keys
+	KEY_AudioLowerVolume = C.GDK_KEY_AudioLowerVolume
+	KEY_AudioMute        = C.GDK_KEY_AudioMute
+	KEY_AudioRaiseVolume = C.GDK_KEY_AudioRaiseVolume
+	KEY_AudioPlay        = C.GDK_KEY_AudioPlay
+	KEY_AudioStop        = C.GDK_KEY_AudioStop
+	KEY_AudioPrev        = C.GDK_KEY_AudioPrev
+	KEY_AudioNext        = C.GDK_KEY_AudioNext
+	KEY_AudioPause       = C.GDK_KEY_AudioPause
+	KEY_AudioRecord      = C.GDK_KEY_AudioRecord
+	KEY_Back             = C.GDK_KEY_Back
+	KEY_Forward          = C.GDK_KEY_Forward
+	KEY_Refresh          = C.GDK_KEY_Refresh
+	KEY_Stop             = C.GDK_KEY_Stop
+	KEY_Search           = C.GDK_KEY_Search
+	KEY_HomePage         = C.GDK_KEY_HomePage
+	KEY_Mail             = C.GDK_KEY_Mail
+	KEY_Calculator       = C.GDK_KEY_Calculator
+	KEY_Copy             = C.GDK_KEY_Copy
+	KEY_Cut              = C.GDK_KEY_Cut
+	KEY_Paste            = C.GDK_KEY_Paste
+	KEY_ZoomIn           = C.GDK_KEY_ZoomIn
+	KEY_ZoomOut          = C.GDK_KEY_ZoomOut
+)
+
+// KeyvalName is a wrapper around gdk_keyval_name().  It returns an empty
+// string if keyval is not a valid key.
+func KeyvalName(keyval uint) string {
+	c := C.gdk_keyval_name(C.guint(keyval))
+	if c == nil {
+		return ""
+	}
+	return C.GoString((*C.char)(c))
+}
+
+// KeyvalFromName is a wrapper around gdk_keyval_from_name().  The name is
+// the keyval name without the KEY_ prefix, such as "Return" or "a".  It
+// returns KEY_VoidSymbol if the name is not a valid keyval name.
+func KeyvalFromName(keyvalName string) uint {
+	cstr := C.CString(keyvalName)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.gdk_keyval_from_name((*C.gchar)(cstr))
+	return uint(c)
+}
+
+// KeyvalToUnicode is a wrapper around gdk_keyval_to_unicode().  It
+// returns 0 if the key has no corresponding character.
+func KeyvalToUnicode(keyval uint) rune {
+	c := C.gdk_keyval_to_unicode(C.guint(keyval))
+	return rune(c)
+}
+
+// UnicodeToKeyval is a wrapper around gdk_unicode_to_keyval().  Characters
+// without a corresponding keyval are returned as the character with bit
+// 0x01000000 set.
+func UnicodeToKeyval(wc rune) uint {
+	c := C.gdk_unicode_to_keyval(C.guint32(wc))
+	return uint(c)
+}
+
+// KeyvalToLower is a wrapper around gdk_keyval_to_lower().
+func KeyvalToLower(keyval uint) uint {
+	c := C.gdk_keyval_to_lower(C.guint(keyval))
+	return uint(c)
+}
+
+// KeyvalToUpper is a wrapper around gdk_keyval_to_upper().
+func KeyvalToUpper(keyval uint) uint {
+	c := C.gdk_keyval_to_upper(C.guint(keyval))
+	return uint(c)
+}
+
+// KeyvalIsLower is a wrapper around gdk_keyval_is_lower().
+func KeyvalIsLower(keyval uint) bool {
+	c := C.gdk_keyval_is_lower(C.guint(keyval))
+	return gobool(c)
+}
+
+// KeyvalIsUpper is a wrapper around gdk_keyval_is_upper().
+func KeyvalIsUpper(keyval uint) bool {
+	c := C.gdk_keyval_is_upper(C.guint(keyval))
+	return gobool(c)
+}
+
+// KeyvalConvertCase is a wrapper around gdk_keyval_convert_case().
+func KeyvalConvertCase(symbol uint) (lower, upper uint) {
+	var clower, cupper C.guint
+	C.gdk_keyval_convert_case(C.guint(symbol), &clower, &cupper)
+	return uint(clower), uint(cupper)
+}
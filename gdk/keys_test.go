@@ -0,0 +1,36 @@
+package gdk_test
+
+import (
+	"testing"
+
+	"github.com/conformal/gotk3/gdk"
+)
+
+// TestKeyval tests conversions between keyvals, names and characters.
+func TestKeyval(t *testing.T) {
+	if name := gdk.KeyvalName(gdk.KEY_Return); name != "Return" {
+		t.Errorf("KeyvalName returned %q", name)
+	}
+	if key := gdk.KeyvalFromName("Escape"); key != gdk.KEY_Escape {
+		t.Errorf("KeyvalFromName returned %#x", key)
+	}
+	if key := gdk.KeyvalFromName("NotAKey"); key != gdk.KEY_VoidSymbol {
+		t.Errorf("KeyvalFromName returned %#x for invalid name", key)
+	}
+	if r := gdk.KeyvalToUnicode(gdk.KEY_a); r != 'a' {
+		t.Errorf("KeyvalToUnicode returned %q", r)
+	}
+	if key := gdk.UnicodeToKeyval('A'); key != gdk.KEY_A {
+		t.Errorf("UnicodeToKeyval returned %#x", key)
+	}
+	if gdk.KeyvalToUpper(gdk.KEY_a) != gdk.KEY_A ||
+		gdk.KeyvalToLower(gdk.KEY_A) != gdk.KEY_a {
+		t.Error("Keyval case conversion failed")
+	}
+	if lower, upper := gdk.KeyvalConvertCase(gdk.KEY_b); lower != gdk.KEY_b || upper != gdk.KEY_B {
+		t.Errorf("KeyvalConvertCase returned %#x, %#x", lower, upper)
+	}
+	if !gdk.KeyvalIsLower(gdk.KEY_a) || gdk.KeyvalIsUpper(gdk.KEY_a) {
+		t.Error("KEY_a not reported as lower case")
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+// This file includes wrapers for symbols included since GLib 2.38, and
+// and should not be included in a build intended to target any older GLib
+// versions.  To target an older build, such as 2.36, use
+// 'go build -tags glib_2_36'.  Otherwise, if no build tags are used, GLib
+// 2.40 is assumed and this file is built.
+// +build !glib_2_36
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0 gio-2.0
+// #include <gio/gio.h>
+// #include "glib.go.h"
+//
+// static GBytesIcon *
+// toGBytesIcon(void *p)
+// {
+// 	return (G_BYTES_ICON(p));
+// }
+import "C"
+import (
+	"runtime"
+	"unsafe"
+)
+
+func init() {
+	tm := []TypeMarshaler{
+		// Objects/Interfaces
+		{Type(C.g_bytes_icon_get_type()), marshalBytesIcon},
+	}
+	RegisterGValueMarshalers(tm)
+}
+
+/*
+ * GBytesIcon
+ */
+
+// BytesIcon is a representation of GIO's GBytesIcon.  The icon data is
+// an image in any format supported by GdkPixbuf, such as PNG or SVG.
+type BytesIcon struct {
+	Icon
+}
+
+// native returns a pointer to the underlying GBytesIcon.
+func (v *BytesIcon) native() *C.GBytesIcon {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGBytesIcon(p)
+}
+
+// Native returns a pointer to the underlying GBytesIcon.
+func (v *BytesIcon) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalBytesIcon(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	return wrapBytesIcon(newObject(C.toGObject(unsafe.Pointer(c)))), nil
+}
+
+func wrapBytesIcon(obj *Object) *BytesIcon {
+	return &BytesIcon{Icon{obj}}
+}
+
+// BytesIconNew is a wrapper around g_bytes_icon_new().  data is copied,
+// so the slice may be reused after this call returns.
+func BytesIconNew(data []byte) *BytesIcon {
+	var p C.gconstpointer
+	if len(data) > 0 {
+		p = C.gconstpointer(unsafe.Pointer(&data[0]))
+	}
+	bytes := C.g_bytes_new(p, C.gsize(len(data)))
+	defer C.g_bytes_unref(bytes)
+	c := C.g_bytes_icon_new(bytes)
+	obj := newObject(C.toGObject(unsafe.Pointer(c)))
+	runtime.SetFinalizer(obj, (*Object).Unref)
+	return wrapBytesIcon(obj)
+}
+
+// GetBytes is a wrapper around g_bytes_icon_get_bytes().  It returns a
+// copy of the icon data.
+func (v *BytesIcon) GetBytes() []byte {
+	c := C.g_bytes_icon_get_bytes(v.native())
+	return goBytes(C.g_bytes_ref(c))
+}
@@ -0,0 +1,25 @@
+// +build !glib_2_36
+
+package glib_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/conformal/gotk3/glib"
+)
+
+func TestBytesIcon(t *testing.T) {
+	data := []byte("\x89PNG\r\n\x1a\n")
+	icon := glib.BytesIconNew(data)
+	data[0] = 0
+	if got := icon.GetBytes(); !bytes.Equal(got, []byte("\x89PNG\r\n\x1a\n")) {
+		t.Errorf("GetBytes returned %q", got)
+	}
+	if !icon.Equal(glib.BytesIconNew(icon.GetBytes())) {
+		t.Error("Icons with equal bytes compare unequal")
+	}
+	if len(glib.BytesIconNew(nil).GetBytes()) != 0 {
+		t.Error("Empty icon has bytes")
+	}
+}
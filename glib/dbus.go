@@ -0,0 +1,682 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0 gio-2.0
+// #include <gio/gio.h>
+// #include "glib.go.h"
+import "C"
+import (
+	"errors"
+	"runtime"
+	"unsafe"
+)
+
+func init() {
+	tm := []TypeMarshaler{
+		// Enums
+		{Type(C.g_bus_type_get_type()), marshalBusType},
+		{Type(C.g_bus_name_owner_flags_get_type()), marshalBusNameOwnerFlags},
+		{Type(C.g_dbus_call_flags_get_type()), marshalDBusCallFlags},
+		{Type(C.g_dbus_connection_flags_get_type()), marshalDBusConnectionFlags},
+		{Type(C.g_dbus_signal_flags_get_type()), marshalDBusSignalFlags},
+
+		// Objects/Interfaces
+		{Type(C.g_dbus_connection_get_type()), marshalDBusConnection},
+		{Type(C.g_dbus_method_invocation_get_type()), marshalDBusMethodInvocation},
+	}
+	RegisterGValueMarshalers(tm)
+}
+
+/*
+ * Constants
+ */
+
+// BusType is a representation of GIO's GBusType.
+type BusType int
+
+const (
+	BUS_TYPE_STARTER BusType = C.G_BUS_TYPE_STARTER
+	BUS_TYPE_NONE    BusType = C.G_BUS_TYPE_NONE
+	BUS_TYPE_SYSTEM  BusType = C.G_BUS_TYPE_SYSTEM
+	BUS_TYPE_SESSION BusType = C.G_BUS_TYPE_SESSION
+)
+
+func marshalBusType(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return BusType(c), nil
+}
+
+// BusNameOwnerFlags is a representation of GIO's GBusNameOwnerFlags.
+type BusNameOwnerFlags int
+
+const (
+	BUS_NAME_OWNER_FLAGS_NONE              BusNameOwnerFlags = C.G_BUS_NAME_OWNER_FLAGS_NONE
+	BUS_NAME_OWNER_FLAGS_ALLOW_REPLACEMENT BusNameOwnerFlags = C.G_BUS_NAME_OWNER_FLAGS_ALLOW_REPLACEMENT
+	BUS_NAME_OWNER_FLAGS_REPLACE           BusNameOwnerFlags = C.G_BUS_NAME_OWNER_FLAGS_REPLACE
+)
+
+func marshalBusNameOwnerFlags(p uintptr) (interface{}, error) {
+	c := C.g_value_get_flags((*C.GValue)(unsafe.Pointer(p)))
+	return BusNameOwnerFlags(c), nil
+}
+
+// DBusCallFlags is a representation of GIO's GDBusCallFlags.
+type DBusCallFlags int
+
+const (
+	DBUS_CALL_FLAGS_NONE          DBusCallFlags = C.G_DBUS_CALL_FLAGS_NONE
+	DBUS_CALL_FLAGS_NO_AUTO_START DBusCallFlags = C.G_DBUS_CALL_FLAGS_NO_AUTO_START
+)
+
+func marshalDBusCallFlags(p uintptr) (interface{}, error) {
+	c := C.g_value_get_flags((*C.GValue)(unsafe.Pointer(p)))
+	return DBusCallFlags(c), nil
+}
+
+// DBusConnectionFlags is a representation of GIO's GDBusConnectionFlags.
+type DBusConnectionFlags int
+
+const (
+	DBUS_CONNECTION_FLAGS_NONE                           DBusConnectionFlags = C.G_DBUS_CONNECTION_FLAGS_NONE
+	DBUS_CONNECTION_FLAGS_AUTHENTICATION_CLIENT          DBusConnectionFlags = C.G_DBUS_CONNECTION_FLAGS_AUTHENTICATION_CLIENT
+	DBUS_CONNECTION_FLAGS_AUTHENTICATION_SERVER          DBusConnectionFlags = C.G_DBUS_CONNECTION_FLAGS_AUTHENTICATION_SERVER
+	DBUS_CONNECTION_FLAGS_AUTHENTICATION_ALLOW_ANONYMOUS DBusConnectionFlags = C.G_DBUS_CONNECTION_FLAGS_AUTHENTICATION_ALLOW_ANONYMOUS
+	DBUS_CONNECTION_FLAGS_MESSAGE_BUS_CONNECTION         DBusConnectionFlags = C.G_DBUS_CONNECTION_FLAGS_MESSAGE_BUS_CONNECTION
+	DBUS_CONNECTION_FLAGS_DELAY_MESSAGE_PROCESSING       DBusConnectionFlags = C.G_DBUS_CONNECTION_FLAGS_DELAY_MESSAGE_PROCESSING
+)
+
+func marshalDBusConnectionFlags(p uintptr) (interface{}, error) {
+	c := C.g_value_get_flags((*C.GValue)(unsafe.Pointer(p)))
+	return DBusConnectionFlags(c), nil
+}
+
+// DBusSignalFlags is a representation of GIO's GDBusSignalFlags.
+type DBusSignalFlags int
+
+const (
+	DBUS_SIGNAL_FLAGS_NONE                 DBusSignalFlags = C.G_DBUS_SIGNAL_FLAGS_NONE
+	DBUS_SIGNAL_FLAGS_NO_MATCH_RULE        DBusSignalFlags = C.G_DBUS_SIGNAL_FLAGS_NO_MATCH_RULE
+	DBUS_SIGNAL_FLAGS_MATCH_ARG0_NAMESPACE DBusSignalFlags = C.G_DBUS_SIGNAL_FLAGS_MATCH_ARG0_NAMESPACE
+	DBUS_SIGNAL_FLAGS_MATCH_ARG0_PATH      DBusSignalFlags = C.G_DBUS_SIGNAL_FLAGS_MATCH_ARG0_PATH
+)
+
+func marshalDBusSignalFlags(p uintptr) (interface{}, error) {
+	c := C.g_value_get_flags((*C.GValue)(unsafe.Pointer(p)))
+	return DBusSignalFlags(c), nil
+}
+
+// cStringOrNil returns a C copy of s, or nil if s is empty.  A non-nil
+// result must be freed with C.free().
+func cStringOrNil(s string) *C.gchar {
+	if s == "" {
+		return nil
+	}
+	return (*C.gchar)(C.CString(s))
+}
+
+/*
+ * GDBusConnection
+ */
+
+// DBusConnection is a representation of GIO's GDBusConnection.
+type DBusConnection struct {
+	*Object
+}
+
+// native returns a pointer to the underlying GDBusConnection.
+func (v *DBusConnection) native() *C.GDBusConnection {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGDBusConnection(p)
+}
+
+// Native returns a pointer to the underlying GDBusConnection.
+func (v *DBusConnection) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalDBusConnection(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	return wrapDBusConnection(newObject(C.toGObject(unsafe.Pointer(c)))), nil
+}
+
+func wrapDBusConnection(obj *Object) *DBusConnection {
+	return &DBusConnection{obj}
+}
+
+// takeDBusConnection wraps a GDBusConnection returned with full transfer.
+func takeDBusConnection(c *C.GDBusConnection) *DBusConnection {
+	obj := newObject(C.toGObject(unsafe.Pointer(c)))
+	runtime.SetFinalizer(obj, (*Object).Unref)
+	return wrapDBusConnection(obj)
+}
+
+// refDBusConnection wraps a GDBusConnection returned without a transfer
+// of ownership.  nil is returned if c is NULL.
+func refDBusConnection(c *C.GDBusConnection) *DBusConnection {
+	if c == nil {
+		return nil
+	}
+	obj := newObject(C.toGObject(unsafe.Pointer(c)))
+	obj.Ref()
+	runtime.SetFinalizer(obj, (*Object).Unref)
+	return wrapDBusConnection(obj)
+}
+
+// BusGetSync is a wrapper around g_bus_get_sync().
+func BusGetSync(busType BusType) (*DBusConnection, error) {
+	var err *C.GError
+	c := C.g_bus_get_sync(C.GBusType(busType), nil, &err)
+	if c == nil {
+		defer C.g_error_free(err)
+		return nil, errors.New(C.GoString((*C.char)(err.message)))
+	}
+	return takeDBusConnection(c), nil
+}
+
+// DBusConnectionNewForAddressSync is a wrapper around
+// g_dbus_connection_new_for_address_sync().  To connect to a message bus
+// at address, such as a private bus started for tests, use the flags
+// DBUS_CONNECTION_FLAGS_AUTHENTICATION_CLIENT and
+// DBUS_CONNECTION_FLAGS_MESSAGE_BUS_CONNECTION.
+func DBusConnectionNewForAddressSync(address string, flags DBusConnectionFlags) (*DBusConnection, error) {
+	cstr := C.CString(address)
+	defer C.free(unsafe.Pointer(cstr))
+	var err *C.GError
+	c := C.g_dbus_connection_new_for_address_sync((*C.gchar)(cstr),
+		C.GDBusConnectionFlags(flags), nil, nil, &err)
+	if c == nil {
+		defer C.g_error_free(err)
+		return nil, errors.New(C.GoString((*C.char)(err.message)))
+	}
+	return takeDBusConnection(c), nil
+}
+
+// GetUniqueName is a wrapper around g_dbus_connection_get_unique_name().
+func (v *DBusConnection) GetUniqueName() string {
+	c := C.g_dbus_connection_get_unique_name(v.native())
+	return C.GoString((*C.char)(c))
+}
+
+// Close is a wrapper around g_dbus_connection_close_sync().
+func (v *DBusConnection) Close() error {
+	var err *C.GError
+	c := C.g_dbus_connection_close_sync(v.native(), nil, &err)
+	if !gobool(c) {
+		defer C.g_error_free(err)
+		return errors.New(C.GoString((*C.char)(err.message)))
+	}
+	return nil
+}
+
+// IsClosed is a wrapper around g_dbus_connection_is_closed().
+func (v *DBusConnection) IsClosed() bool {
+	c := C.g_dbus_connection_is_closed(v.native())
+	return gobool(c)
+}
+
+// Flush is a wrapper around g_dbus_connection_flush_sync().
+func (v *DBusConnection) Flush() error {
+	var err *C.GError
+	c := C.g_dbus_connection_flush_sync(v.native(), nil, &err)
+	if !gobool(c) {
+		defer C.g_error_free(err)
+		return errors.New(C.GoString((*C.char)(err.message)))
+	}
+	return nil
+}
+
+// dbusCallArgs holds the C strings shared by CallSync and Call.
+type dbusCallArgs struct {
+	busName, objectPath, interfaceName, methodName *C.gchar
+	replyType                                      *C.GVariantType
+}
+
+func newDBusCallArgs(busName, objectPath, interfaceName, methodName, replyType string) (*dbusCallArgs, error) {
+	a := &dbusCallArgs{}
+	if replyType != "" {
+		t, err := variantType(replyType)
+		if err != nil {
+			return nil, err
+		}
+		a.replyType = t
+	}
+	a.busName = cStringOrNil(busName)
+	a.objectPath = (*C.gchar)(C.CString(objectPath))
+	a.interfaceName = (*C.gchar)(C.CString(interfaceName))
+	a.methodName = (*C.gchar)(C.CString(methodName))
+	return a, nil
+}
+
+func (a *dbusCallArgs) free() {
+	C.free(unsafe.Pointer(a.busName))
+	C.free(unsafe.Pointer(a.objectPath))
+	C.free(unsafe.Pointer(a.interfaceName))
+	C.free(unsafe.Pointer(a.methodName))
+	C.free(unsafe.Pointer(a.replyType))
+}
+
+// CallSync is a wrapper around g_dbus_connection_call_sync().  busName
+// may be empty if v is not a message bus connection.  parameters must be
+// a tuple or nil, and replyType may be empty to accept any reply.
+// timeoutMsec is -1 for the default timeout.
+//
+// CallSync blocks the calling goroutine and must not be used to call
+// objects exported on the same connection from the thread running the
+// main loop.
+func (v *DBusConnection) CallSync(busName, objectPath, interfaceName, methodName string, parameters *Variant, replyType string, flags DBusCallFlags, timeoutMsec int) (*Variant, error) {
+	a, err := newDBusCallArgs(busName, objectPath, interfaceName, methodName, replyType)
+	if err != nil {
+		return nil, err
+	}
+	defer a.free()
+	var gerr *C.GError
+	c := C.g_dbus_connection_call_sync(v.native(), a.busName, a.objectPath,
+		a.interfaceName, a.methodName, parameters.native(), a.replyType,
+		C.GDBusCallFlags(flags), C.gint(timeoutMsec), nil, &gerr)
+	if c == nil {
+		defer C.g_error_free(gerr)
+		return nil, errors.New(C.GoString((*C.char)(gerr.message)))
+	}
+	return takeVariant(c), nil
+}
+
+// Call is a wrapper around g_dbus_connection_call().  It takes the same
+// arguments as CallSync, but returns immediately.  f is called from the
+// main loop with the reply or an error once the call completes.
+func (v *DBusConnection) Call(busName, objectPath, interfaceName, methodName string, parameters *Variant, replyType string, flags DBusCallFlags, timeoutMsec int, f func(reply *Variant, err error)) error {
+	a, err := newDBusCallArgs(busName, objectPath, interfaceName, methodName, replyType)
+	if err != nil {
+		return err
+	}
+	defer a.free()
+	data := registerCallback(asyncReadyCallback(func(res *C.GAsyncResult) {
+		var err *C.GError
+		c := C.g_dbus_connection_call_finish(v.native(), res, &err)
+		if c == nil {
+			defer C.g_error_free(err)
+			f(nil, errors.New(C.GoString((*C.char)(err.message))))
+			return
+		}
+		f(takeVariant(c), nil)
+	}))
+	C._g_dbus_connection_call(v.native(), a.busName, a.objectPath,
+		a.interfaceName, a.methodName, parameters.native(), a.replyType,
+		C.GDBusCallFlags(flags), C.gint(timeoutMsec), data)
+	return nil
+}
+
+// EmitSignal is a wrapper around g_dbus_connection_emit_signal().
+// destinationBusName may be empty to broadcast the signal.  parameters
+// must be a tuple or nil.
+func (v *DBusConnection) EmitSignal(destinationBusName, objectPath, interfaceName, signalName string, parameters *Variant) error {
+	cdest := cStringOrNil(destinationBusName)
+	defer C.free(unsafe.Pointer(cdest))
+	cpath := C.CString(objectPath)
+	defer C.free(unsafe.Pointer(cpath))
+	ciface := C.CString(interfaceName)
+	defer C.free(unsafe.Pointer(ciface))
+	csignal := C.CString(signalName)
+	defer C.free(unsafe.Pointer(csignal))
+	var err *C.GError
+	c := C.g_dbus_connection_emit_signal(v.native(), cdest,
+		(*C.gchar)(cpath), (*C.gchar)(ciface), (*C.gchar)(csignal),
+		parameters.native(), &err)
+	if !gobool(c) {
+		defer C.g_error_free(err)
+		return errors.New(C.GoString((*C.char)(err.message)))
+	}
+	return nil
+}
+
+// DBusSignalCallback is the type of functions called when a signal
+// subscribed to with SignalSubscribe is received.
+type DBusSignalCallback func(conn *DBusConnection, senderName, objectPath, interfaceName, signalName string, parameters *Variant)
+
+// SignalSubscribe is a wrapper around
+// g_dbus_connection_signal_subscribe().  Empty strings match any sender,
+// interface, member, object path or first argument.  The returned
+// subscription id may be passed to SignalUnsubscribe.
+func (v *DBusConnection) SignalSubscribe(sender, interfaceName, member, objectPath, arg0 string, flags DBusSignalFlags, f DBusSignalCallback) uint {
+	csender := cStringOrNil(sender)
+	defer C.free(unsafe.Pointer(csender))
+	ciface := cStringOrNil(interfaceName)
+	defer C.free(unsafe.Pointer(ciface))
+	cmember := cStringOrNil(member)
+	defer C.free(unsafe.Pointer(cmember))
+	cpath := cStringOrNil(objectPath)
+	defer C.free(unsafe.Pointer(cpath))
+	carg0 := cStringOrNil(arg0)
+	defer C.free(unsafe.Pointer(carg0))
+	c := C._g_dbus_connection_signal_subscribe(v.native(), csender, ciface,
+		cmember, cpath, carg0, C.GDBusSignalFlags(flags),
+		registerCallback(f))
+	return uint(c)
+}
+
+// SignalUnsubscribe is a wrapper around
+// g_dbus_connection_signal_unsubscribe().
+func (v *DBusConnection) SignalUnsubscribe(subscriptionID uint) {
+	C.g_dbus_connection_signal_unsubscribe(v.native(), C.guint(subscriptionID))
+}
+
+//export goDBusSignalCallback
+func goDBusSignalCallback(conn *C.GDBusConnection, senderName, objectPath,
+	interfaceName, signalName *C.gchar, parameters *C.GVariant, data C.gpointer) {
+
+	f := getCallback(data).(DBusSignalCallback)
+	f(refDBusConnection(conn), C.GoString((*C.char)(senderName)),
+		C.GoString((*C.char)(objectPath)),
+		C.GoString((*C.char)(interfaceName)),
+		C.GoString((*C.char)(signalName)), refVariant(parameters))
+}
+
+// DBusMethodCallback is the type of functions handling method calls on
+// objects exported with RegisterObject.  The handler must eventually
+// complete the invocation with one of its Return methods, either before
+// returning or later from the main loop.
+type DBusMethodCallback func(invocation *DBusMethodInvocation)
+
+// RegisterObject is a wrapper around
+// g_dbus_connection_register_object().  Method calls on the interface
+// described by interfaceInfo at objectPath are passed to f.  Property
+// access through org.freedesktop.DBus.Properties is passed to f as
+// well.  The returned registration id may be passed to
+// UnregisterObject.
+func (v *DBusConnection) RegisterObject(objectPath string, interfaceInfo *DBusInterfaceInfo, f DBusMethodCallback) (uint, error) {
+	cstr := C.CString(objectPath)
+	defer C.free(unsafe.Pointer(cstr))
+	var err *C.GError
+	c := C._g_dbus_connection_register_object(v.native(), (*C.gchar)(cstr),
+		interfaceInfo.native(), registerCallback(f), &err)
+	if c == 0 {
+		defer C.g_error_free(err)
+		return 0, errors.New(C.GoString((*C.char)(err.message)))
+	}
+	return uint(c), nil
+}
+
+// UnregisterObject is a wrapper around
+// g_dbus_connection_unregister_object().
+func (v *DBusConnection) UnregisterObject(registrationID uint) bool {
+	c := C.g_dbus_connection_unregister_object(v.native(), C.guint(registrationID))
+	return gobool(c)
+}
+
+//export goDBusMethodCall
+func goDBusMethodCall(conn *C.GDBusConnection, sender, objectPath,
+	interfaceName, methodName *C.gchar, parameters *C.GVariant,
+	invocation *C.GDBusMethodInvocation, data C.gpointer) {
+
+	f := getCallback(data).(DBusMethodCallback)
+	obj := newObject(C.toGObject(unsafe.Pointer(invocation)))
+	f(wrapDBusMethodInvocation(obj))
+}
+
+/*
+ * GDBusMethodInvocation
+ */
+
+// DBusMethodInvocation is a representation of GIO's
+// GDBusMethodInvocation.
+type DBusMethodInvocation struct {
+	*Object
+}
+
+// native returns a pointer to the underlying GDBusMethodInvocation.
+func (v *DBusMethodInvocation) native() *C.GDBusMethodInvocation {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGDBusMethodInvocation(p)
+}
+
+// Native returns a pointer to the underlying GDBusMethodInvocation.
+func (v *DBusMethodInvocation) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalDBusMethodInvocation(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	return wrapDBusMethodInvocation(newObject(C.toGObject(unsafe.Pointer(c)))), nil
+}
+
+func wrapDBusMethodInvocation(obj *Object) *DBusMethodInvocation {
+	return &DBusMethodInvocation{obj}
+}
+
+// GetConnection is a wrapper around
+// g_dbus_method_invocation_get_connection().
+func (v *DBusMethodInvocation) GetConnection() *DBusConnection {
+	c := C.g_dbus_method_invocation_get_connection(v.native())
+	return refDBusConnection(c)
+}
+
+// GetSender is a wrapper around g_dbus_method_invocation_get_sender().
+func (v *DBusMethodInvocation) GetSender() string {
+	c := C.g_dbus_method_invocation_get_sender(v.native())
+	return C.GoString((*C.char)(c))
+}
+
+// GetObjectPath is a wrapper around
+// g_dbus_method_invocation_get_object_path().
+func (v *DBusMethodInvocation) GetObjectPath() string {
+	c := C.g_dbus_method_invocation_get_object_path(v.native())
+	return C.GoString((*C.char)(c))
+}
+
+// GetInterfaceName is a wrapper around
+// g_dbus_method_invocation_get_interface_name().
+func (v *DBusMethodInvocation) GetInterfaceName() string {
+	c := C.g_dbus_method_invocation_get_interface_name(v.native())
+	return C.GoString((*C.char)(c))
+}
+
+// GetMethodName is a wrapper around
+// g_dbus_method_invocation_get_method_name().
+func (v *DBusMethodInvocation) GetMethodName() string {
+	c := C.g_dbus_method_invocation_get_method_name(v.native())
+	return C.GoString((*C.char)(c))
+}
+
+// GetParameters is a wrapper around
+// g_dbus_method_invocation_get_parameters().  The parameters are always
+// a tuple.
+func (v *DBusMethodInvocation) GetParameters() *Variant {
+	c := C.g_dbus_method_invocation_get_parameters(v.native())
+	return refVariant(c)
+}
+
+// ReturnValue is a wrapper around
+// g_dbus_method_invocation_return_value().  parameters must be a tuple,
+// or nil if the method has no out arguments.  This completes the
+// invocation, and v may not be used afterwards.
+func (v *DBusMethodInvocation) ReturnValue(parameters *Variant) {
+	C.g_dbus_method_invocation_return_value(v.native(), parameters.native())
+}
+
+// ReturnDBusError is a wrapper around
+// g_dbus_method_invocation_return_dbus_error().  This completes the
+// invocation, and v may not be used afterwards.
+func (v *DBusMethodInvocation) ReturnDBusError(errorName, errorMessage string) {
+	cname := C.CString(errorName)
+	defer C.free(unsafe.Pointer(cname))
+	cmsg := C.CString(errorMessage)
+	defer C.free(unsafe.Pointer(cmsg))
+	C.g_dbus_method_invocation_return_dbus_error(v.native(),
+		(*C.gchar)(cname), (*C.gchar)(cmsg))
+}
+
+/*
+ * GDBusNodeInfo
+ */
+
+// DBusNodeInfo is a representation of GIO's GDBusNodeInfo.
+type DBusNodeInfo struct {
+	info *C.GDBusNodeInfo
+}
+
+// native returns a pointer to the underlying GDBusNodeInfo.
+func (v *DBusNodeInfo) native() *C.GDBusNodeInfo {
+	if v == nil {
+		return nil
+	}
+	return v.info
+}
+
+// Native returns a pointer to the underlying GDBusNodeInfo.
+func (v *DBusNodeInfo) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func (v *DBusNodeInfo) unref() {
+	C.g_dbus_node_info_unref(v.native())
+}
+
+// DBusNodeInfoNewForXML is a wrapper around
+// g_dbus_node_info_new_for_xml().
+func DBusNodeInfoNewForXML(xmlData string) (*DBusNodeInfo, error) {
+	cstr := C.CString(xmlData)
+	defer C.free(unsafe.Pointer(cstr))
+	var err *C.GError
+	c := C.g_dbus_node_info_new_for_xml((*C.gchar)(cstr), &err)
+	if c == nil {
+		defer C.g_error_free(err)
+		return nil, errors.New(C.GoString((*C.char)(err.message)))
+	}
+	n := &DBusNodeInfo{c}
+	runtime.SetFinalizer(n, (*DBusNodeInfo).unref)
+	return n, nil
+}
+
+// LookupInterface is a wrapper around
+// g_dbus_node_info_lookup_interface().  nil is returned if the node has
+// no interface called name.
+func (v *DBusNodeInfo) LookupInterface(name string) *DBusInterfaceInfo {
+	cstr := C.CString(name)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.g_dbus_node_info_lookup_interface(v.native(), (*C.gchar)(cstr))
+	if c == nil {
+		return nil
+	}
+	i := &DBusInterfaceInfo{C.g_dbus_interface_info_ref(c)}
+	runtime.SetFinalizer(i, (*DBusInterfaceInfo).unref)
+	return i
+}
+
+/*
+ * GDBusInterfaceInfo
+ */
+
+// DBusInterfaceInfo is a representation of GIO's GDBusInterfaceInfo.
+type DBusInterfaceInfo struct {
+	info *C.GDBusInterfaceInfo
+}
+
+// native returns a pointer to the underlying GDBusInterfaceInfo.
+func (v *DBusInterfaceInfo) native() *C.GDBusInterfaceInfo {
+	if v == nil {
+		return nil
+	}
+	return v.info
+}
+
+// Native returns a pointer to the underlying GDBusInterfaceInfo.
+func (v *DBusInterfaceInfo) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func (v *DBusInterfaceInfo) unref() {
+	C.g_dbus_interface_info_unref(v.native())
+}
+
+// GetName returns the name of the interface.
+func (v *DBusInterfaceInfo) GetName() string {
+	return C.GoString((*C.char)(v.native().name))
+}
+
+/*
+ * Bus name ownership
+ */
+
+// BusNameCallback is the type of functions called when a connection to
+// the bus is acquired, or a bus name is acquired or lost.  conn is nil
+// if the name was lost because the connection to the bus could not be
+// made.
+type BusNameCallback func(conn *DBusConnection, name string)
+
+// busNameCallbacks holds the callbacks passed to BusOwnName.
+type busNameCallbacks struct {
+	busAcquired, nameAcquired, nameLost BusNameCallback
+}
+
+// BusOwnName is a wrapper around g_bus_own_name().  Any of the callbacks
+// may be nil.  The returned owner id must be passed to BusUnownName to
+// release the name.
+func BusOwnName(busType BusType, name string, flags BusNameOwnerFlags, busAcquired, nameAcquired, nameLost BusNameCallback) uint {
+	cstr := C.CString(name)
+	defer C.free(unsafe.Pointer(cstr))
+	data := registerCallback(&busNameCallbacks{busAcquired, nameAcquired, nameLost})
+	c := C._g_bus_own_name(C.GBusType(busType), (*C.gchar)(cstr),
+		C.GBusNameOwnerFlags(flags), data)
+	return uint(c)
+}
+
+// BusOwnNameOnConnection is a wrapper around
+// g_bus_own_name_on_connection().  Either callback may be nil.  The
+// returned owner id must be passed to BusUnownName to release the name.
+func BusOwnNameOnConnection(conn *DBusConnection, name string, flags BusNameOwnerFlags, nameAcquired, nameLost BusNameCallback) uint {
+	cstr := C.CString(name)
+	defer C.free(unsafe.Pointer(cstr))
+	data := registerCallback(&busNameCallbacks{nil, nameAcquired, nameLost})
+	c := C._g_bus_own_name_on_connection(conn.native(), (*C.gchar)(cstr),
+		C.GBusNameOwnerFlags(flags), data)
+	return uint(c)
+}
+
+// BusUnownName is a wrapper around g_bus_unown_name().
+func BusUnownName(ownerID uint) {
+	C.g_bus_unown_name(C.guint(ownerID))
+}
+
+//export goBusAcquired
+func goBusAcquired(conn *C.GDBusConnection, name *C.gchar, data C.gpointer) {
+	cbs := getCallback(data).(*busNameCallbacks)
+	if cbs.busAcquired != nil {
+		cbs.busAcquired(refDBusConnection(conn), C.GoString((*C.char)(name)))
+	}
+}
+
+//export goBusNameAcquired
+func goBusNameAcquired(conn *C.GDBusConnection, name *C.gchar, data C.gpointer) {
+	cbs := getCallback(data).(*busNameCallbacks)
+	if cbs.nameAcquired != nil {
+		cbs.nameAcquired(refDBusConnection(conn), C.GoString((*C.char)(name)))
+	}
+}
+
+//export goBusNameLost
+func goBusNameLost(conn *C.GDBusConnection, name *C.gchar, data C.gpointer) {
+	cbs := getCallback(data).(*busNameCallbacks)
+	if cbs.nameLost != nil {
+		cbs.nameLost(refDBusConnection(conn), C.GoString((*C.char)(name)))
+	}
+}
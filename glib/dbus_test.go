@@ -0,0 +1,153 @@
+package glib_test
+
+import (
+	"bufio"
+	"os/exec"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/conformal/gotk3/glib"
+	"github.com/conformal/gotk3/gtk"
+)
+
+const (
+	testBusName       = "com.github.conformal.gotk3.Test"
+	testObjectPath    = "/com/github/conformal/gotk3/Test"
+	testInterfaceName = "com.github.conformal.gotk3.Test"
+)
+
+const testIntrospectionXML = `<node>
+  <interface name="com.github.conformal.gotk3.Test">
+    <method name="Greet">
+      <arg type="s" name="name" direction="in"/>
+      <arg type="s" name="greeting" direction="out"/>
+    </method>
+    <method name="Fail"/>
+    <signal name="Greeted">
+      <arg type="s" name="name"/>
+    </signal>
+  </interface>
+</node>`
+
+// newTestBus starts a private message bus and returns connections to it
+// for a server and a client.  The returned func stops the bus.
+func newTestBus(t *testing.T) (server, client *glib.DBusConnection, cleanup func()) {
+	daemon, err := exec.LookPath("dbus-daemon")
+	if err != nil {
+		t.Skip("dbus-daemon not found")
+	}
+	cmd := exec.Command(daemon, "--session", "--nofork", "--print-address")
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	cleanup = func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	}
+	address, err := bufio.NewReader(stdout).ReadString('\n')
+	if err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+	address = strings.TrimSpace(address)
+
+	flags := glib.DBUS_CONNECTION_FLAGS_AUTHENTICATION_CLIENT |
+		glib.DBUS_CONNECTION_FLAGS_MESSAGE_BUS_CONNECTION
+	if server, err = glib.DBusConnectionNewForAddressSync(address, flags); err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+	if client, err = glib.DBusConnectionNewForAddressSync(address, flags); err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+	return server, client, cleanup
+}
+
+func TestDBus(t *testing.T) {
+	runtime.LockOSThread()
+
+	server, client, cleanup := newTestBus(t)
+	defer cleanup()
+
+	node, err := glib.DBusNodeInfoNewForXML(testIntrospectionXML)
+	if err != nil {
+		t.Fatal(err)
+	}
+	iface := node.LookupInterface(testInterfaceName)
+	if iface == nil {
+		t.Fatal("LookupInterface did not find test interface")
+	}
+	_, err = server.RegisterObject(testObjectPath, iface, func(inv *glib.DBusMethodInvocation) {
+		switch inv.GetMethodName() {
+		case "Greet":
+			name := inv.GetParameters().GetChildValue(0).GetString()
+			server.EmitSignal("", testObjectPath, testInterfaceName, "Greeted",
+				glib.VariantNewTuple(glib.VariantNewString(name)))
+			inv.ReturnValue(glib.VariantNewTuple(glib.VariantNewString("Hello, " + name)))
+		default:
+			inv.ReturnDBusError("com.github.conformal.gotk3.Error.Failed", "failed")
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var greeted, greeting string
+	var failErr error
+	client.SignalSubscribe("", testInterfaceName, "Greeted", testObjectPath, "",
+		glib.DBUS_SIGNAL_FLAGS_NONE, func(conn *glib.DBusConnection, sender, path, iface, signal string, params *glib.Variant) {
+			greeted = params.GetChildValue(0).GetString()
+		})
+
+	done := false
+	quit := func() {
+		done = true
+		gtk.MainQuit()
+	}
+	owner := glib.BusOwnNameOnConnection(server, testBusName, glib.BUS_NAME_OWNER_FLAGS_NONE,
+		func(conn *glib.DBusConnection, name string) {
+			args := glib.VariantNewTuple(glib.VariantNewString("gotk3"))
+			client.Call(name, testObjectPath, testInterfaceName, "Greet", args, "(s)",
+				glib.DBUS_CALL_FLAGS_NONE, -1, func(reply *glib.Variant, err error) {
+					if err != nil {
+						t.Error(err)
+					} else {
+						greeting = reply.GetChildValue(0).GetString()
+					}
+					client.Call(name, testObjectPath, testInterfaceName, "Fail", nil, "",
+						glib.DBUS_CALL_FLAGS_NONE, -1, func(reply *glib.Variant, err error) {
+							failErr = err
+							quit()
+						})
+				})
+		},
+		func(conn *glib.DBusConnection, name string) {
+			t.Errorf("Lost bus name %s", name)
+			quit()
+		})
+	defer glib.BusUnownName(owner)
+
+	glib.TimeoutAdd(5000, func() {
+		if !done {
+			t.Error("Timed out waiting for D-Bus replies")
+			quit()
+		}
+	})
+	gtk.Main()
+
+	if greeting != "Hello, gotk3" {
+		t.Errorf("Greet returned %q", greeting)
+	}
+	if greeted != "gotk3" {
+		t.Errorf("Greeted signal carried %q", greeted)
+	}
+	if failErr == nil || !strings.Contains(failErr.Error(), "com.github.conformal.gotk3.Error.Failed") {
+		t.Errorf("Fail returned error %v", failErr)
+	}
+}
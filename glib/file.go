@@ -0,0 +1,132 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0 gio-2.0
+// #include <gio/gio.h>
+// #include "glib.go.h"
+import "C"
+import (
+	"runtime"
+	"unsafe"
+)
+
+func init() {
+	tm := []TypeMarshaler{
+		// Objects/Interfaces
+		{Type(C.g_file_get_type()), marshalFile},
+	}
+	RegisterGValueMarshalers(tm)
+}
+
+/*
+ * GFile
+ */
+
+// File is a representation of GIO's GFile.
+type File struct {
+	*Object
+}
+
+// native returns a pointer to the underlying GFile.
+func (v *File) native() *C.GFile {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGFile(p)
+}
+
+// Native returns a pointer to the underlying GFile.
+func (v *File) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalFile(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	return wrapFile(newObject(C.toGObject(unsafe.Pointer(c)))), nil
+}
+
+func wrapFile(obj *Object) *File {
+	return &File{obj}
+}
+
+// takeFile wraps a GFile returned with full transfer.
+func takeFile(c *C.GFile) *File {
+	obj := newObject(C.toGObject(unsafe.Pointer(c)))
+	runtime.SetFinalizer(obj, (*Object).Unref)
+	return wrapFile(obj)
+}
+
+// FileNewForPath is a wrapper around g_file_new_for_path().
+func FileNewForPath(path string) *File {
+	cstr := C.CString(path)
+	defer C.free(unsafe.Pointer(cstr))
+	return takeFile(C.g_file_new_for_path((*C.char)(cstr)))
+}
+
+// FileNewForURI is a wrapper around g_file_new_for_uri().
+func FileNewForURI(uri string) *File {
+	cstr := C.CString(uri)
+	defer C.free(unsafe.Pointer(cstr))
+	return takeFile(C.g_file_new_for_uri((*C.char)(cstr)))
+}
+
+// FileParseName is a wrapper around g_file_parse_name().
+func FileParseName(parseName string) *File {
+	cstr := C.CString(parseName)
+	defer C.free(unsafe.Pointer(cstr))
+	return takeFile(C.g_file_parse_name((*C.char)(cstr)))
+}
+
+// GetPath is a wrapper around g_file_get_path().  A non-nil error is
+// returned if the file has no local path.
+func (v *File) GetPath() (string, error) {
+	c := C.g_file_get_path(v.native())
+	if c == nil {
+		return "", errNilPtr
+	}
+	defer C.g_free(C.gpointer(c))
+	return C.GoString(c), nil
+}
+
+// GetURI is a wrapper around g_file_get_uri().
+func (v *File) GetURI() string {
+	c := C.g_file_get_uri(v.native())
+	defer C.g_free(C.gpointer(c))
+	return C.GoString(c)
+}
+
+// GetParseName is a wrapper around g_file_get_parse_name().
+func (v *File) GetParseName() string {
+	c := C.g_file_get_parse_name(v.native())
+	defer C.g_free(C.gpointer(c))
+	return C.GoString(c)
+}
+
+// GetBasename is a wrapper around g_file_get_basename().
+func (v *File) GetBasename() string {
+	c := C.g_file_get_basename(v.native())
+	defer C.g_free(C.gpointer(c))
+	return C.GoString(c)
+}
+
+// Equal is a wrapper around g_file_equal().
+func (v *File) Equal(file *File) bool {
+	c := C.g_file_equal(v.native(), file.native())
+	return gobool(c)
+}
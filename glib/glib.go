@@ -18,7 +18,8 @@
 // and later.
 package glib
 
-// #cgo pkg-config: glib-2.0 gobject-2.0
+// #cgo pkg-config: glib-2.0 gobject-2.0 gio-2.0
+// #include <gio/gio.h>
 // #include <glib.h>
 // #include <glib-object.h>
 // #include "glib.go.h"
@@ -50,6 +51,30 @@ func gobool(b C.gboolean) bool {
 	return false
 }
 
+// goStrings converts a NULL-terminated C array of strings to a Go slice.
+func goStrings(c **C.gchar) []string {
+	var strs []string
+	if c == nil {
+		return strs
+	}
+	for p := c; *p != nil; p = (**C.gchar)(unsafe.Pointer(uintptr(unsafe.Pointer(p)) + unsafe.Sizeof(*p))) {
+		strs = append(strs, C.GoString((*C.char)(*p)))
+	}
+	return strs
+}
+
+// cStrings converts a Go slice of strings to a NULL-terminated C array
+// of strings.  The returned array must be freed with g_strfreev().
+func cStrings(strs []string) **C.gchar {
+	c := C._g_strv_new(C.int(len(strs)))
+	for i, s := range strs {
+		cstr := C.CString(s)
+		C._g_strv_set(c, C.int(i), (*C.gchar)(cstr))
+		C.free(unsafe.Pointer(cstr))
+	}
+	return c
+}
+
 /*
  * Unexported vars
  */
@@ -70,6 +95,13 @@ var (
 	}
 
 	signals = make(map[SignalHandle]*C.GClosure)
+
+	callbacks = struct {
+		sync.RWMutex
+		m map[C.gpointer]interface{}
+	}{
+		m: make(map[C.gpointer]interface{}),
+	}
 )
 
 /*
@@ -291,6 +323,58 @@ func goMarshal(closure *C.GClosure, retValue *C.GValue,
 	}
 }
 
+/*
+ * Callback support
+ */
+
+// registerCallback saves a Go func for C APIs which take a function
+// pointer and user data instead of a GClosure.  The returned pointer is
+// passed as the user data and identifies f to the Go function exported
+// for the C callback.  It must be released with removeCallback, either
+// directly or by passing removeCallback as the GDestroyNotify.
+func registerCallback(f interface{}) C.gpointer {
+	// Allocate a byte of C memory so each callback has a unique
+	// pointer that is safe to hand to C code.
+	p := C.gpointer(C.malloc(1))
+	callbacks.Lock()
+	callbacks.m[p] = f
+	callbacks.Unlock()
+	return p
+}
+
+// getCallback returns the Go func saved by registerCallback.
+func getCallback(p C.gpointer) interface{} {
+	callbacks.RLock()
+	defer callbacks.RUnlock()
+	return callbacks.m[p]
+}
+
+// removeCallback removes a Go func saved by registerCallback.
+//
+//export removeCallback
+func removeCallback(p C.gpointer) {
+	callbacks.Lock()
+	delete(callbacks.m, p)
+	callbacks.Unlock()
+	C.free(unsafe.Pointer(p))
+}
+
+// asyncReadyCallback is the Go func type saved for GAsyncReadyCallbacks.
+// It is called with the GAsyncResult to pass to the matching _finish()
+// function.
+type asyncReadyCallback func(res *C.GAsyncResult)
+
+// goAsyncReadyCallback is called by GIO when an asynchronous operation
+// started with a callback saved by registerCallback completes.  Async
+// operations call back exactly once, so the callback is removed here.
+//
+//export goAsyncReadyCallback
+func goAsyncReadyCallback(_ *C.GObject, res *C.GAsyncResult, data C.gpointer) {
+	f := getCallback(data).(asyncReadyCallback)
+	removeCallback(data)
+	f(res)
+}
+
 // gValueSlice converts a C array of GValues to a Go slice.
 func gValueSlice(values *C.GValue, nValues int) (slice []C.GValue) {
 	header := (*reflect.SliceHeader)((unsafe.Pointer(&slice)))
@@ -913,6 +997,14 @@ func GValue(v interface{}) (gvalue *Value, err error) {
 		val.SetInstance(uintptr(unsafe.Pointer(e.GObject)))
 		return val, nil
 
+	case *Variant:
+		val, err := ValueInit(TYPE_VARIANT)
+		if err != nil {
+			return nil, err
+		}
+		C.g_value_set_variant(val.native(), e.native())
+		return val, nil
+
 	default:
 		/* Try this since above doesn't catch constants under other types */
 		rval := reflect.ValueOf(v)
@@ -1125,7 +1217,11 @@ func marshalObject(p uintptr) (interface{}, error) {
 }
 
 func marshalVariant(p uintptr) (interface{}, error) {
-	return nil, errors.New("variant conversion not yet implemented")
+	c := C.g_value_get_variant((*C.GValue)(unsafe.Pointer(p)))
+	if c == nil {
+		return (*Variant)(nil), nil
+	}
+	return refVariant(c), nil
 }
 
 // GoValue converts a Value to comparable Go type.  GoValue()
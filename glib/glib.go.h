@@ -16,6 +16,9 @@
  * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
  */
 
+#ifndef __GLIB_GO_H__
+#define __GLIB_GO_H__
+
 #include <stdint.h>
 #include <stdlib.h>
 #include <stdio.h>
@@ -33,6 +36,73 @@ _g_type_from_instance(gpointer instance)
 	return (G_TYPE_FROM_INSTANCE(instance));
 }
 
+/* GIO Type Casting */
+static GSettings *
+toGSettings(void *p)
+{
+	return (G_SETTINGS(p));
+}
+
+static GInputStream *
+toGInputStream(void *p)
+{
+	return (G_INPUT_STREAM(p));
+}
+
+static GOutputStream *
+toGOutputStream(void *p)
+{
+	return (G_OUTPUT_STREAM(p));
+}
+
+static GFile *
+toGFile(void *p)
+{
+	return (G_FILE(p));
+}
+
+static GIcon *
+toGIcon(void *p)
+{
+	return (G_ICON(p));
+}
+
+static GThemedIcon *
+toGThemedIcon(void *p)
+{
+	return (G_THEMED_ICON(p));
+}
+
+static GFileIcon *
+toGFileIcon(void *p)
+{
+	return (G_FILE_ICON(p));
+}
+
+static GEmblem *
+toGEmblem(void *p)
+{
+	return (G_EMBLEM(p));
+}
+
+static GEmblemedIcon *
+toGEmblemedIcon(void *p)
+{
+	return (G_EMBLEMED_ICON(p));
+}
+
+static GDBusConnection *
+toGDBusConnection(void *p)
+{
+	return (G_DBUS_CONNECTION(p));
+}
+
+static GDBusMethodInvocation *
+toGDBusMethodInvocation(void *p)
+{
+	return (G_DBUS_METHOD_INVOCATION(p));
+}
+
 /* Wrapper to avoid variable arg list */
 static void
 _g_object_set_one(gpointer object, const gchar *property_name, void *val)
@@ -55,6 +125,22 @@ val_list_insert(GValue *valv, int i, GValue *val)
 	valv[i] = *val;
 }
 
+/*
+ * String vectors
+ */
+
+static gchar **
+_g_strv_new(int n)
+{
+	return (g_new0(gchar *, n + 1));
+}
+
+static void
+_g_strv_set(gchar **strv, int i, gchar *s)
+{
+	strv[i] = g_strdup(s);
+}
+
 /*
  * GValue
  */
@@ -115,3 +201,83 @@ _g_closure_add_finalize_notifier(GClosure *closure)
 {
 	g_closure_add_finalize_notifier(closure, NULL, removeClosure);
 }
+
+/*
+ * Callback support
+ */
+
+extern void	removeCallback(gpointer);
+extern void	goAsyncReadyCallback(GObject *, GAsyncResult *, gpointer);
+
+/*
+ * GDBus
+ */
+
+static void
+_g_dbus_connection_call(GDBusConnection *connection, const gchar *bus_name,
+    const gchar *object_path, const gchar *interface_name,
+    const gchar *method_name, GVariant *parameters,
+    const GVariantType *reply_type, GDBusCallFlags flags, gint timeout_msec,
+    gpointer user_data)
+{
+	g_dbus_connection_call(connection, bus_name, object_path,
+	    interface_name, method_name, parameters, reply_type, flags,
+	    timeout_msec, NULL, (GAsyncReadyCallback)(goAsyncReadyCallback),
+	    user_data);
+}
+
+extern void	goDBusSignalCallback(GDBusConnection *, gchar *, gchar *,
+		    gchar *, gchar *, GVariant *, gpointer);
+
+static guint
+_g_dbus_connection_signal_subscribe(GDBusConnection *connection,
+    const gchar *sender, const gchar *interface_name, const gchar *member,
+    const gchar *object_path, const gchar *arg0, GDBusSignalFlags flags,
+    gpointer user_data)
+{
+	return (g_dbus_connection_signal_subscribe(connection, sender,
+	    interface_name, member, object_path, arg0, flags,
+	    (GDBusSignalCallback)(goDBusSignalCallback), user_data,
+	    removeCallback));
+}
+
+extern void	goDBusMethodCall(GDBusConnection *, gchar *, gchar *, gchar *,
+		    gchar *, GVariant *, GDBusMethodInvocation *, gpointer);
+
+static guint
+_g_dbus_connection_register_object(GDBusConnection *connection,
+    const gchar *object_path, GDBusInterfaceInfo *interface_info,
+    gpointer user_data, GError **error)
+{
+	static const GDBusInterfaceVTable vtable = {
+		(GDBusInterfaceMethodCallFunc)(goDBusMethodCall), NULL, NULL
+	};
+
+	return (g_dbus_connection_register_object(connection, object_path,
+	    interface_info, &vtable, user_data, removeCallback, error));
+}
+
+extern void	goBusAcquired(GDBusConnection *, gchar *, gpointer);
+extern void	goBusNameAcquired(GDBusConnection *, gchar *, gpointer);
+extern void	goBusNameLost(GDBusConnection *, gchar *, gpointer);
+
+static guint
+_g_bus_own_name(GBusType bus_type, const gchar *name,
+    GBusNameOwnerFlags flags, gpointer user_data)
+{
+	return (g_bus_own_name(bus_type, name, flags,
+	    (GBusAcquiredCallback)(goBusAcquired),
+	    (GBusNameAcquiredCallback)(goBusNameAcquired),
+	    (GBusNameLostCallback)(goBusNameLost), user_data, removeCallback));
+}
+
+static guint
+_g_bus_own_name_on_connection(GDBusConnection *connection, const gchar *name,
+    GBusNameOwnerFlags flags, gpointer user_data)
+{
+	return (g_bus_own_name_on_connection(connection, name, flags,
+	    (GBusNameAcquiredCallback)(goBusNameAcquired),
+	    (GBusNameLostCallback)(goBusNameLost), user_data, removeCallback));
+}
+
+#endif
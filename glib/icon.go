@@ -0,0 +1,422 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0 gio-2.0
+// #include <gio/gio.h>
+// #include "glib.go.h"
+import "C"
+import (
+	"errors"
+	"runtime"
+	"unsafe"
+)
+
+func init() {
+	tm := []TypeMarshaler{
+		// Enums
+		{Type(C.g_emblem_origin_get_type()), marshalEmblemOrigin},
+
+		// Objects/Interfaces
+		{Type(C.g_icon_get_type()), marshalIcon},
+		{Type(C.g_themed_icon_get_type()), marshalThemedIcon},
+		{Type(C.g_file_icon_get_type()), marshalFileIcon},
+		{Type(C.g_emblem_get_type()), marshalEmblem},
+		{Type(C.g_emblemed_icon_get_type()), marshalEmblemedIcon},
+	}
+	RegisterGValueMarshalers(tm)
+}
+
+// EmblemOrigin is a representation of GIO's GEmblemOrigin.
+type EmblemOrigin int
+
+const (
+	EMBLEM_ORIGIN_UNKNOWN      EmblemOrigin = C.G_EMBLEM_ORIGIN_UNKNOWN
+	EMBLEM_ORIGIN_DEVICE       EmblemOrigin = C.G_EMBLEM_ORIGIN_DEVICE
+	EMBLEM_ORIGIN_LIVEMETADATA EmblemOrigin = C.G_EMBLEM_ORIGIN_LIVEMETADATA
+	EMBLEM_ORIGIN_TAG          EmblemOrigin = C.G_EMBLEM_ORIGIN_TAG
+)
+
+func marshalEmblemOrigin(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return EmblemOrigin(c), nil
+}
+
+/*
+ * GIcon
+ */
+
+// IIcon is an interface type implemented by Icon and all types which
+// embed an Icon, such as ThemedIcon and FileIcon.  It is meant to be used
+// as a type for function arguments which require GIcons.  Native returns
+// a pointer which may be cast to a GIcon.
+type IIcon interface {
+	toIcon() *Icon
+	Native() uintptr
+}
+
+// Icon is a representation of GIO's GIcon.  Icons returned by functions
+// which may return any kind of GIcon are represented by an Icon.
+type Icon struct {
+	*Object
+}
+
+// native returns a pointer to the underlying GIcon.
+func (v *Icon) native() *C.GIcon {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGIcon(p)
+}
+
+// Native returns a pointer to the underlying GIcon.
+func (v *Icon) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func (v *Icon) toIcon() *Icon {
+	return v
+}
+
+// toGIcon returns the underlying GIcon of an IIcon, or nil if icon is
+// nil or holds a nil pointer.
+func toGIcon(icon IIcon) *C.GIcon {
+	if icon == nil || icon.Native() == 0 {
+		return nil
+	}
+	return icon.toIcon().native()
+}
+
+func marshalIcon(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	return wrapIcon(newObject(C.toGObject(unsafe.Pointer(c)))), nil
+}
+
+func wrapIcon(obj *Object) *Icon {
+	return &Icon{obj}
+}
+
+// takeIcon wraps a GIcon returned with full transfer.
+func takeIcon(c *C.GIcon) *Icon {
+	obj := newObject(C.toGObject(unsafe.Pointer(c)))
+	runtime.SetFinalizer(obj, (*Object).Unref)
+	return wrapIcon(obj)
+}
+
+// IconNewForString is a wrapper around g_icon_new_for_string().  It
+// creates an icon from a string returned by ToString.
+func IconNewForString(str string) (*Icon, error) {
+	cstr := C.CString(str)
+	defer C.free(unsafe.Pointer(cstr))
+	var err *C.GError
+	c := C.g_icon_new_for_string((*C.gchar)(cstr), &err)
+	if c == nil {
+		defer C.g_error_free(err)
+		return nil, errors.New(C.GoString((*C.char)(err.message)))
+	}
+	return takeIcon(c), nil
+}
+
+// ToString is a wrapper around g_icon_to_string().  A non-nil error is
+// returned if the icon cannot be serialized.
+func (v *Icon) ToString() (string, error) {
+	c := C.g_icon_to_string(v.native())
+	if c == nil {
+		return "", errors.New("icon cannot be serialized")
+	}
+	defer C.g_free(C.gpointer(c))
+	return C.GoString((*C.char)(c)), nil
+}
+
+// Equal is a wrapper around g_icon_equal().
+func (v *Icon) Equal(icon IIcon) bool {
+	c := C.g_icon_equal(v.native(), toGIcon(icon))
+	return gobool(c)
+}
+
+// Hash is a wrapper around g_icon_hash().
+func (v *Icon) Hash() uint {
+	c := C.g_icon_hash(C.gconstpointer(unsafe.Pointer(v.native())))
+	return uint(c)
+}
+
+/*
+ * GThemedIcon
+ */
+
+// ThemedIcon is a representation of GIO's GThemedIcon.
+type ThemedIcon struct {
+	Icon
+}
+
+// native returns a pointer to the underlying GThemedIcon.
+func (v *ThemedIcon) native() *C.GThemedIcon {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGThemedIcon(p)
+}
+
+// Native returns a pointer to the underlying GThemedIcon.
+func (v *ThemedIcon) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalThemedIcon(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	return wrapThemedIcon(newObject(C.toGObject(unsafe.Pointer(c)))), nil
+}
+
+func wrapThemedIcon(obj *Object) *ThemedIcon {
+	return &ThemedIcon{Icon{obj}}
+}
+
+func takeThemedIcon(c *C.GIcon) *ThemedIcon {
+	obj := newObject(C.toGObject(unsafe.Pointer(c)))
+	runtime.SetFinalizer(obj, (*Object).Unref)
+	return wrapThemedIcon(obj)
+}
+
+// ThemedIconNew is a wrapper around g_themed_icon_new().
+func ThemedIconNew(iconName string) *ThemedIcon {
+	cstr := C.CString(iconName)
+	defer C.free(unsafe.Pointer(cstr))
+	return takeThemedIcon(C.g_themed_icon_new((*C.char)(cstr)))
+}
+
+// ThemedIconNewWithDefaultFallbacks is a wrapper around
+// g_themed_icon_new_with_default_fallbacks().  Names made by removing
+// dash-separated suffixes from iconName are used as fallbacks, so
+// "edit-copy-symbolic" falls back to "edit-copy" and "edit".
+func ThemedIconNewWithDefaultFallbacks(iconName string) *ThemedIcon {
+	cstr := C.CString(iconName)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.g_themed_icon_new_with_default_fallbacks((*C.char)(cstr))
+	return takeThemedIcon(c)
+}
+
+// ThemedIconNewFromNames is a wrapper around
+// g_themed_icon_new_from_names().  Names are tried in order.
+func ThemedIconNewFromNames(iconNames []string) *ThemedIcon {
+	cnames := cStrings(iconNames)
+	defer C.g_strfreev(cnames)
+	c := C.g_themed_icon_new_from_names((**C.char)(unsafe.Pointer(cnames)), -1)
+	return takeThemedIcon(c)
+}
+
+// PrependName is a wrapper around g_themed_icon_prepend_name().
+func (v *ThemedIcon) PrependName(iconName string) {
+	cstr := C.CString(iconName)
+	defer C.free(unsafe.Pointer(cstr))
+	C.g_themed_icon_prepend_name(v.native(), (*C.char)(cstr))
+}
+
+// AppendName is a wrapper around g_themed_icon_append_name().
+func (v *ThemedIcon) AppendName(iconName string) {
+	cstr := C.CString(iconName)
+	defer C.free(unsafe.Pointer(cstr))
+	C.g_themed_icon_append_name(v.native(), (*C.char)(cstr))
+}
+
+// GetNames is a wrapper around g_themed_icon_get_names().
+func (v *ThemedIcon) GetNames() []string {
+	c := C.g_themed_icon_get_names(v.native())
+	return goStrings((**C.gchar)(unsafe.Pointer(c)))
+}
+
+/*
+ * GFileIcon
+ */
+
+// FileIcon is a representation of GIO's GFileIcon.
+type FileIcon struct {
+	Icon
+}
+
+// native returns a pointer to the underlying GFileIcon.
+func (v *FileIcon) native() *C.GFileIcon {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGFileIcon(p)
+}
+
+// Native returns a pointer to the underlying GFileIcon.
+func (v *FileIcon) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalFileIcon(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	return wrapFileIcon(newObject(C.toGObject(unsafe.Pointer(c)))), nil
+}
+
+func wrapFileIcon(obj *Object) *FileIcon {
+	return &FileIcon{Icon{obj}}
+}
+
+// FileIconNew is a wrapper around g_file_icon_new().
+func FileIconNew(file *File) *FileIcon {
+	c := C.g_file_icon_new(file.native())
+	obj := newObject(C.toGObject(unsafe.Pointer(c)))
+	runtime.SetFinalizer(obj, (*Object).Unref)
+	return wrapFileIcon(obj)
+}
+
+// GetFile is a wrapper around g_file_icon_get_file().
+func (v *FileIcon) GetFile() *File {
+	c := C.g_file_icon_get_file(v.native())
+	obj := newObject(C.toGObject(unsafe.Pointer(c)))
+	obj.Ref()
+	runtime.SetFinalizer(obj, (*Object).Unref)
+	return wrapFile(obj)
+}
+
+/*
+ * GEmblem
+ */
+
+// Emblem is a representation of GIO's GEmblem.  Emblems are icons, and
+// are added to other icons with EmblemedIcon.
+type Emblem struct {
+	Icon
+}
+
+// native returns a pointer to the underlying GEmblem.
+func (v *Emblem) native() *C.GEmblem {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGEmblem(p)
+}
+
+func marshalEmblem(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	return wrapEmblem(newObject(C.toGObject(unsafe.Pointer(c)))), nil
+}
+
+func wrapEmblem(obj *Object) *Emblem {
+	return &Emblem{Icon{obj}}
+}
+
+func takeEmblem(c *C.GEmblem) *Emblem {
+	obj := newObject(C.toGObject(unsafe.Pointer(c)))
+	runtime.SetFinalizer(obj, (*Object).Unref)
+	return wrapEmblem(obj)
+}
+
+// EmblemNew is a wrapper around g_emblem_new().
+func EmblemNew(icon IIcon) *Emblem {
+	return takeEmblem(C.g_emblem_new(toGIcon(icon)))
+}
+
+// EmblemNewWithOrigin is a wrapper around g_emblem_new_with_origin().
+func EmblemNewWithOrigin(icon IIcon, origin EmblemOrigin) *Emblem {
+	c := C.g_emblem_new_with_origin(toGIcon(icon), C.GEmblemOrigin(origin))
+	return takeEmblem(c)
+}
+
+// GetIcon is a wrapper around g_emblem_get_icon().
+func (v *Emblem) GetIcon() *Icon {
+	c := C.g_emblem_get_icon(v.native())
+	obj := newObject(C.toGObject(unsafe.Pointer(c)))
+	obj.Ref()
+	runtime.SetFinalizer(obj, (*Object).Unref)
+	return wrapIcon(obj)
+}
+
+// GetOrigin is a wrapper around g_emblem_get_origin().
+func (v *Emblem) GetOrigin() EmblemOrigin {
+	c := C.g_emblem_get_origin(v.native())
+	return EmblemOrigin(c)
+}
+
+/*
+ * GEmblemedIcon
+ */
+
+// EmblemedIcon is a representation of GIO's GEmblemedIcon.
+type EmblemedIcon struct {
+	Icon
+}
+
+// native returns a pointer to the underlying GEmblemedIcon.
+func (v *EmblemedIcon) native() *C.GEmblemedIcon {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGEmblemedIcon(p)
+}
+
+// Native returns a pointer to the underlying GEmblemedIcon.
+func (v *EmblemedIcon) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalEmblemedIcon(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	return wrapEmblemedIcon(newObject(C.toGObject(unsafe.Pointer(c)))), nil
+}
+
+func wrapEmblemedIcon(obj *Object) *EmblemedIcon {
+	return &EmblemedIcon{Icon{obj}}
+}
+
+// EmblemedIconNew is a wrapper around g_emblemed_icon_new().  emblem
+// may be nil.
+func EmblemedIconNew(icon IIcon, emblem *Emblem) *EmblemedIcon {
+	c := C.g_emblemed_icon_new(toGIcon(icon), emblem.native())
+	obj := newObject(C.toGObject(unsafe.Pointer(c)))
+	runtime.SetFinalizer(obj, (*Object).Unref)
+	return wrapEmblemedIcon(obj)
+}
+
+// GetIcon is a wrapper around g_emblemed_icon_get_icon().
+func (v *EmblemedIcon) GetIcon() *Icon {
+	c := C.g_emblemed_icon_get_icon(v.native())
+	obj := newObject(C.toGObject(unsafe.Pointer(c)))
+	obj.Ref()
+	runtime.SetFinalizer(obj, (*Object).Unref)
+	return wrapIcon(obj)
+}
+
+// GetEmblems is a wrapper around g_emblemed_icon_get_emblems().
+func (v *EmblemedIcon) GetEmblems() []*Emblem {
+	var emblems []*Emblem
+	for l := C.g_emblemed_icon_get_emblems(v.native()); l != nil; l = l.next {
+		obj := newObject(C.toGObject(unsafe.Pointer(l.data)))
+		obj.Ref()
+		runtime.SetFinalizer(obj, (*Object).Unref)
+		emblems = append(emblems, wrapEmblem(obj))
+	}
+	return emblems
+}
+
+// AddEmblem is a wrapper around g_emblemed_icon_add_emblem().
+func (v *EmblemedIcon) AddEmblem(emblem *Emblem) {
+	C.g_emblemed_icon_add_emblem(v.native(), emblem.native())
+}
+
+// ClearEmblems is a wrapper around g_emblemed_icon_clear_emblems().
+func (v *EmblemedIcon) ClearEmblems() {
+	C.g_emblemed_icon_clear_emblems(v.native())
+}
@@ -0,0 +1,85 @@
+package glib_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/conformal/gotk3/glib"
+)
+
+func TestThemedIcon(t *testing.T) {
+	icon := glib.ThemedIconNewWithDefaultFallbacks("edit-copy-symbolic")
+	names := icon.GetNames()
+	if len(names) < 3 || names[0] != "edit-copy-symbolic" {
+		t.Fatalf("Unexpected names %v", names)
+	}
+	icon.AppendName("document")
+	names = icon.GetNames()
+	if names[len(names)-1] != "document" {
+		t.Errorf("AppendName did not append to %v", names)
+	}
+
+	var nilIcon *glib.ThemedIcon
+	if icon.Equal(nilIcon) {
+		t.Error("Icon equal to nil icon")
+	}
+
+	fromNames := glib.ThemedIconNewFromNames([]string{"a", "b"})
+	if !reflect.DeepEqual(fromNames.GetNames(), []string{"a", "b"}) {
+		t.Errorf("Unexpected names %v", fromNames.GetNames())
+	}
+}
+
+func TestIconToString(t *testing.T) {
+	icons := []interface {
+		glib.IIcon
+		ToString() (string, error)
+	}{
+		glib.ThemedIconNewFromNames([]string{"folder", "folder-open"}),
+		glib.FileIconNew(glib.FileNewForPath("/tmp/icon.png")),
+		glib.EmblemedIconNew(glib.ThemedIconNew("folder"),
+			glib.EmblemNewWithOrigin(glib.ThemedIconNew("emblem-shared"),
+				glib.EMBLEM_ORIGIN_TAG)),
+	}
+	for _, icon := range icons {
+		s, err := icon.ToString()
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		parsed, err := glib.IconNewForString(s)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if !parsed.Equal(icon) {
+			t.Errorf("Icon parsed from %q is not equal to original", s)
+		}
+	}
+
+	if s, _ := glib.ThemedIconNew("folder").ToString(); s != "folder" {
+		t.Errorf("Themed icon serialized as %q", s)
+	}
+	if _, err := glib.IconNewForString(""); err == nil {
+		t.Error("Expected error for empty string")
+	}
+}
+
+func TestEmblemedIcon(t *testing.T) {
+	icon := glib.EmblemedIconNew(glib.ThemedIconNew("folder"), nil)
+	if len(icon.GetEmblems()) != 0 {
+		t.Error("Expected no emblems")
+	}
+	icon.AddEmblem(glib.EmblemNew(glib.ThemedIconNew("emblem-shared")))
+	emblems := icon.GetEmblems()
+	if len(emblems) != 1 || !emblems[0].GetIcon().Equal(glib.ThemedIconNew("emblem-shared")) {
+		t.Error("AddEmblem did not add emblem")
+	}
+	if !icon.GetIcon().Equal(glib.ThemedIconNew("folder")) {
+		t.Error("GetIcon did not return the base icon")
+	}
+	icon.ClearEmblems()
+	if len(icon.GetEmblems()) != 0 {
+		t.Error("ClearEmblems did not clear emblems")
+	}
+}
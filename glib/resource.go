@@ -0,0 +1,255 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0 gio-2.0
+// #include <gio/gio.h>
+// #include "glib.go.h"
+import "C"
+import (
+	"errors"
+	"runtime"
+	"unsafe"
+)
+
+// ResourceFlags is a representation of GIO's GResourceFlags.
+type ResourceFlags int
+
+const (
+	RESOURCE_FLAGS_NONE       ResourceFlags = C.G_RESOURCE_FLAGS_NONE
+	RESOURCE_FLAGS_COMPRESSED ResourceFlags = C.G_RESOURCE_FLAGS_COMPRESSED
+)
+
+// ResourceLookupFlags is a representation of GIO's GResourceLookupFlags.
+type ResourceLookupFlags int
+
+const (
+	RESOURCE_LOOKUP_FLAGS_NONE ResourceLookupFlags = C.G_RESOURCE_LOOKUP_FLAGS_NONE
+)
+
+/*
+ * GResource
+ */
+
+// Resource is a representation of GIO's GResource.
+//
+// A Resource may be created from the output of glib-compile-resources
+// (for example, a file embedded in the binary) or from a bundle created
+// by a ResourceBuilder.  Once registered, the resources it contains are
+// available to all APIs taking resource paths, such as
+// gtk.Builder.AddFromResource and gtk.ImageNewFromResource.
+type Resource struct {
+	resource *C.GResource
+}
+
+// native returns a pointer to the underlying GResource.
+func (v *Resource) native() *C.GResource {
+	if v == nil {
+		return nil
+	}
+	return v.resource
+}
+
+// Native returns a pointer to the underlying GResource.
+func (v *Resource) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func wrapResource(resource *C.GResource) *Resource {
+	r := &Resource{resource}
+	runtime.SetFinalizer(r, (*Resource).unref)
+	return r
+}
+
+func (v *Resource) unref() {
+	C.g_resource_unref(v.native())
+}
+
+// ResourceNewFromData is a wrapper around g_resource_new_from_data().
+// data is copied, so the slice may be reused after this call returns.
+func ResourceNewFromData(data []byte) (*Resource, error) {
+	var p C.gconstpointer
+	if len(data) > 0 {
+		p = C.gconstpointer(unsafe.Pointer(&data[0]))
+	}
+	bytes := C.g_bytes_new(p, C.gsize(len(data)))
+	defer C.g_bytes_unref(bytes)
+	var err *C.GError
+	c := C.g_resource_new_from_data(bytes, &err)
+	if c == nil {
+		defer C.g_error_free(err)
+		return nil, errors.New(C.GoString((*C.char)(err.message)))
+	}
+	return wrapResource(c), nil
+}
+
+// ResourceLoad is a wrapper around g_resource_load().
+func ResourceLoad(filename string) (*Resource, error) {
+	cstr := C.CString(filename)
+	defer C.free(unsafe.Pointer(cstr))
+	var err *C.GError
+	c := C.g_resource_load((*C.gchar)(cstr), &err)
+	if c == nil {
+		defer C.g_error_free(err)
+		return nil, errors.New(C.GoString((*C.char)(err.message)))
+	}
+	return wrapResource(c), nil
+}
+
+// Register is a wrapper around g_resources_register().
+func (v *Resource) Register() {
+	C.g_resources_register(v.native())
+}
+
+// Unregister is a wrapper around g_resources_unregister().
+func (v *Resource) Unregister() {
+	C.g_resources_unregister(v.native())
+}
+
+// goBytes copies the contents of a GBytes to a Go byte slice and
+// releases the GBytes.
+func goBytes(bytes *C.GBytes) []byte {
+	defer C.g_bytes_unref(bytes)
+	var size C.gsize
+	data := C.g_bytes_get_data(bytes, &size)
+	return C.GoBytes(unsafe.Pointer(data), C.int(size))
+}
+
+// LookupData is a wrapper around g_resource_lookup_data().
+func (v *Resource) LookupData(path string, lookupFlags ResourceLookupFlags) ([]byte, error) {
+	cstr := C.CString(path)
+	defer C.free(unsafe.Pointer(cstr))
+	var err *C.GError
+	c := C.g_resource_lookup_data(v.native(), (*C.char)(cstr),
+		C.GResourceLookupFlags(lookupFlags), &err)
+	if c == nil {
+		defer C.g_error_free(err)
+		return nil, errors.New(C.GoString((*C.char)(err.message)))
+	}
+	return goBytes(c), nil
+}
+
+// OpenStream is a wrapper around g_resource_open_stream().
+func (v *Resource) OpenStream(path string, lookupFlags ResourceLookupFlags) (*InputStream, error) {
+	cstr := C.CString(path)
+	defer C.free(unsafe.Pointer(cstr))
+	var err *C.GError
+	c := C.g_resource_open_stream(v.native(), (*C.char)(cstr),
+		C.GResourceLookupFlags(lookupFlags), &err)
+	if c == nil {
+		defer C.g_error_free(err)
+		return nil, errors.New(C.GoString((*C.char)(err.message)))
+	}
+	return takeInputStream(c), nil
+}
+
+// EnumerateChildren is a wrapper around g_resource_enumerate_children().
+// Names of child directories are returned with a trailing slash.
+func (v *Resource) EnumerateChildren(path string, lookupFlags ResourceLookupFlags) ([]string, error) {
+	cstr := C.CString(path)
+	defer C.free(unsafe.Pointer(cstr))
+	var err *C.GError
+	c := C.g_resource_enumerate_children(v.native(), (*C.char)(cstr),
+		C.GResourceLookupFlags(lookupFlags), &err)
+	if c == nil {
+		defer C.g_error_free(err)
+		return nil, errors.New(C.GoString((*C.char)(err.message)))
+	}
+	defer C.g_strfreev(c)
+	return goStrings(c), nil
+}
+
+// GetInfo is a wrapper around g_resource_get_info().
+func (v *Resource) GetInfo(path string, lookupFlags ResourceLookupFlags) (size uint, flags ResourceFlags, e error) {
+	cstr := C.CString(path)
+	defer C.free(unsafe.Pointer(cstr))
+	var csize C.gsize
+	var cflags C.guint32
+	var err *C.GError
+	c := C.g_resource_get_info(v.native(), (*C.char)(cstr),
+		C.GResourceLookupFlags(lookupFlags), &csize, &cflags, &err)
+	if !gobool(c) {
+		defer C.g_error_free(err)
+		return 0, 0, errors.New(C.GoString((*C.char)(err.message)))
+	}
+	return uint(csize), ResourceFlags(cflags), nil
+}
+
+/*
+ * Global resources
+ */
+
+// ResourcesLookupData is a wrapper around g_resources_lookup_data().
+func ResourcesLookupData(path string, lookupFlags ResourceLookupFlags) ([]byte, error) {
+	cstr := C.CString(path)
+	defer C.free(unsafe.Pointer(cstr))
+	var err *C.GError
+	c := C.g_resources_lookup_data((*C.char)(cstr),
+		C.GResourceLookupFlags(lookupFlags), &err)
+	if c == nil {
+		defer C.g_error_free(err)
+		return nil, errors.New(C.GoString((*C.char)(err.message)))
+	}
+	return goBytes(c), nil
+}
+
+// ResourcesOpenStream is a wrapper around g_resources_open_stream().
+func ResourcesOpenStream(path string, lookupFlags ResourceLookupFlags) (*InputStream, error) {
+	cstr := C.CString(path)
+	defer C.free(unsafe.Pointer(cstr))
+	var err *C.GError
+	c := C.g_resources_open_stream((*C.char)(cstr),
+		C.GResourceLookupFlags(lookupFlags), &err)
+	if c == nil {
+		defer C.g_error_free(err)
+		return nil, errors.New(C.GoString((*C.char)(err.message)))
+	}
+	return takeInputStream(c), nil
+}
+
+// ResourcesEnumerateChildren is a wrapper around
+// g_resources_enumerate_children().  Names of child directories are
+// returned with a trailing slash.
+func ResourcesEnumerateChildren(path string, lookupFlags ResourceLookupFlags) ([]string, error) {
+	cstr := C.CString(path)
+	defer C.free(unsafe.Pointer(cstr))
+	var err *C.GError
+	c := C.g_resources_enumerate_children((*C.char)(cstr),
+		C.GResourceLookupFlags(lookupFlags), &err)
+	if c == nil {
+		defer C.g_error_free(err)
+		return nil, errors.New(C.GoString((*C.char)(err.message)))
+	}
+	defer C.g_strfreev(c)
+	return goStrings(c), nil
+}
+
+// ResourcesGetInfo is a wrapper around g_resources_get_info().
+func ResourcesGetInfo(path string, lookupFlags ResourceLookupFlags) (size uint, flags ResourceFlags, e error) {
+	cstr := C.CString(path)
+	defer C.free(unsafe.Pointer(cstr))
+	var csize C.gsize
+	var cflags C.guint32
+	var err *C.GError
+	c := C.g_resources_get_info((*C.char)(cstr),
+		C.GResourceLookupFlags(lookupFlags), &csize, &cflags, &err)
+	if !gobool(c) {
+		defer C.g_error_free(err)
+		return 0, 0, errors.New(C.GoString((*C.char)(err.message)))
+	}
+	return uint(csize), ResourceFlags(cflags), nil
+}
@@ -0,0 +1,271 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package glib
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ResourceBuilder creates resource bundles in the GVDB file format
+// written by glib-compile-resources, without calling any external tools.
+// The bundle returned by Build may be passed to ResourceNewFromData.
+//
+// Resources are always stored uncompressed.
+type ResourceBuilder struct {
+	files map[string][]byte
+}
+
+// ResourceBuilderNew creates a new, empty ResourceBuilder.
+func ResourceBuilderNew() *ResourceBuilder {
+	return &ResourceBuilder{files: make(map[string][]byte)}
+}
+
+// Add adds data to the bundle at the resource path, which must be
+// absolute and must not name a directory.
+func (b *ResourceBuilder) Add(path string, data []byte) error {
+	if !strings.HasPrefix(path, "/") || strings.HasSuffix(path, "/") {
+		return fmt.Errorf("invalid resource path '%s'", path)
+	}
+	if _, ok := b.files[path]; ok {
+		return fmt.Errorf("duplicate resource path '%s'", path)
+	}
+	b.files[path] = data
+	return nil
+}
+
+// AddDir adds every regular file below the directory dir to the bundle.
+// Each file is added at its path relative to dir, appended to prefix.
+// For example, with the prefix "/org/example/app", the file ui/main.ui
+// is added as "/org/example/app/ui/main.ui".
+func (b *ResourceBuilder) AddDir(dir, prefix string) error {
+	prefix = "/" + strings.Trim(prefix, "/")
+	if prefix != "/" {
+		prefix += "/"
+	}
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return b.Add(prefix+filepath.ToSlash(rel), data)
+	})
+}
+
+// gvdbItem is an entry of a GVDB hash table.  Items either hold a value
+// (files) or a list of children (directories).
+type gvdbItem struct {
+	key      string
+	hash     uint32
+	parent   *gvdbItem
+	children []*gvdbItem
+	value    []byte
+	index    uint32
+}
+
+// gvdbHash is the hash function used for GVDB keys.  Bytes are added as
+// signed chars, matching the C implementation.
+func gvdbHash(key string) uint32 {
+	h := uint32(5381)
+	for i := 0; i < len(key); i++ {
+		h = h*33 + uint32(int32(int8(key[i])))
+	}
+	return h
+}
+
+// gvdbTable holds the items of a GVDB hash table while it is built.
+type gvdbTable struct {
+	items map[string]*gvdbItem
+}
+
+func (t *gvdbTable) insert(key string) *gvdbItem {
+	item := &gvdbItem{key: key, hash: gvdbHash(key)}
+	t.items[key] = item
+	return item
+}
+
+// parent returns the directory item for key, creating it and its own
+// parents when necessary.  The root directory "/" has no parent.
+func (t *gvdbTable) parent(key string) *gvdbItem {
+	if len(key) == 1 {
+		return nil
+	}
+	i := strings.LastIndex(strings.TrimSuffix(key, "/"), "/")
+	pkey := key[:i+1]
+	p, ok := t.items[pkey]
+	if !ok {
+		p = t.insert(pkey)
+		if gp := t.parent(pkey); gp != nil {
+			gp.addChild(p)
+		}
+	}
+	return p
+}
+
+// addChild links child to v, keeping the children sorted by key.
+func (v *gvdbItem) addChild(child *gvdbItem) {
+	child.parent = v
+	i := sort.Search(len(v.children), func(i int) bool {
+		return v.children[i].key > child.key
+	})
+	v.children = append(v.children, nil)
+	copy(v.children[i+1:], v.children[i:])
+	v.children[i] = child
+}
+
+// resourceVariant returns the normal form serialization of a GVariant
+// of type "v" holding the "(uuay)" tuple GResource stores for each file:
+// the size, the flags and the data followed by a terminating nul byte
+// which is not included in the size.
+func resourceVariant(data []byte) []byte {
+	const typ = "(uuay)"
+	v := make([]byte, 8, 8+len(data)+1+1+len(typ))
+	binary.LittleEndian.PutUint32(v[0:], uint32(len(data)))
+	binary.LittleEndian.PutUint32(v[4:], uint32(RESOURCE_FLAGS_NONE))
+	v = append(v, data...)
+	v = append(v, 0)
+	v = append(v, 0)
+	return append(v, typ...)
+}
+
+// gvdbWriter lays out chunks of a GVDB file.
+type gvdbWriter struct {
+	buf []byte
+}
+
+// alloc reserves size bytes aligned to align, returning the start and end
+// offsets of the reserved chunk.  Padding is filled with zeros.
+func (w *gvdbWriter) alloc(align, size int) (start, end uint32) {
+	for len(w.buf)%align != 0 {
+		w.buf = append(w.buf, 0)
+	}
+	start = uint32(len(w.buf))
+	w.buf = append(w.buf, make([]byte, size)...)
+	return start, uint32(len(w.buf))
+}
+
+// Build returns the resource bundle containing all added files.
+func (b *ResourceBuilder) Build() ([]byte, error) {
+	t := &gvdbTable{items: make(map[string]*gvdbItem)}
+	paths := make([]string, 0, len(b.files))
+	for path := range b.files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		item := t.insert(path)
+		item.value = resourceVariant(b.files[path])
+		t.parent(path).addChild(item)
+	}
+
+	// Distribute items over as many buckets as there are items and
+	// assign indexes in bucket order.
+	keys := make([]string, 0, len(t.items))
+	for key := range t.items {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	nBuckets := len(keys)
+	buckets := make([][]*gvdbItem, nBuckets)
+	for _, key := range keys {
+		item := t.items[key]
+		n := item.hash % uint32(nBuckets)
+		buckets[n] = append(buckets[n], item)
+	}
+	var items []*gvdbItem
+	for _, bucket := range buckets {
+		for _, item := range bucket {
+			item.index = uint32(len(items))
+			items = append(items, item)
+		}
+	}
+
+	// The file header is followed by the hash table, which in turn is
+	// followed by the key, value and child list chunks of each item.
+	le := binary.LittleEndian
+	w := &gvdbWriter{}
+	w.alloc(1, 24)
+	copy(w.buf, "GVariant")
+	tableStart, tableEnd := w.alloc(4, 8+4*nBuckets+24*len(items))
+	le.PutUint32(w.buf[16:], tableStart)
+	le.PutUint32(w.buf[20:], tableEnd)
+
+	table := tableStart
+	le.PutUint32(w.buf[table:], 0) // no bloom filter
+	le.PutUint32(w.buf[table+4:], uint32(nBuckets))
+	var index uint32
+	for i, bucket := range buckets {
+		le.PutUint32(w.buf[table+8+4*uint32(i):], index)
+		index += uint32(len(bucket))
+	}
+
+	itemsStart := table + 8 + 4*uint32(nBuckets)
+	for _, item := range items {
+		basename := item.key
+		parent := uint32(0xffffffff)
+		if item.parent != nil {
+			basename = item.key[len(item.parent.key):]
+			parent = item.parent.index
+		}
+		if len(basename) > 0xffff {
+			return nil, fmt.Errorf("resource path '%s' is too long", item.key)
+		}
+		keyStart, _ := w.alloc(1, len(basename))
+		copy(w.buf[keyStart:], basename)
+
+		var typ byte
+		var valueStart, valueEnd uint32
+		if item.value != nil {
+			typ = 'v'
+			valueStart, valueEnd = w.alloc(8, len(item.value))
+			copy(w.buf[valueStart:], item.value)
+		} else {
+			typ = 'L'
+			valueStart, valueEnd = w.alloc(4, 4*len(item.children))
+			for i, child := range item.children {
+				le.PutUint32(w.buf[valueStart+4*uint32(i):], child.index)
+			}
+		}
+
+		entry := w.buf[itemsStart+24*item.index:]
+		le.PutUint32(entry[0:], item.hash)
+		le.PutUint32(entry[4:], parent)
+		le.PutUint32(entry[8:], keyStart)
+		le.PutUint16(entry[12:], uint16(len(basename)))
+		entry[14] = typ
+		entry[15] = 0
+		le.PutUint32(entry[16:], valueStart)
+		le.PutUint32(entry[20:], valueEnd)
+	}
+
+	return w.buf, nil
+}
@@ -0,0 +1,96 @@
+package glib_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/conformal/gotk3/glib"
+)
+
+func TestResourceBuilder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gotk3-resources")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"main.ui":         "<interface/>",
+		"icons/logo.svg":  "<svg/>",
+		"icons/empty.txt": "",
+	}
+	for name, data := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	builder := glib.ResourceBuilderNew()
+	if err := builder.AddDir(dir, "/com/github/conformal/gotk3"); err != nil {
+		t.Fatal(err)
+	}
+	if err := builder.Add("/com/github/conformal/gotk3/main.ui", nil); err == nil {
+		t.Error("Expected error for duplicate resource path")
+	}
+	bundle, err := builder.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resource, err := glib.ResourceNewFromData(bundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resource.Register()
+	defer resource.Unregister()
+
+	for name, expected := range files {
+		path := "/com/github/conformal/gotk3/" + name
+		data, err := glib.ResourcesLookupData(path, glib.RESOURCE_LOOKUP_FLAGS_NONE)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if string(data) != expected {
+			t.Errorf("%s contains %q, expected %q", path, data, expected)
+		}
+		size, _, err := glib.ResourcesGetInfo(path, glib.RESOURCE_LOOKUP_FLAGS_NONE)
+		if err != nil || size != uint(len(expected)) {
+			t.Errorf("%s has size %d, expected %d", path, size, len(expected))
+		}
+	}
+
+	children, err := resource.EnumerateChildren("/com/github/conformal/gotk3/",
+		glib.RESOURCE_LOOKUP_FLAGS_NONE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"icons/", "main.ui"}; !reflect.DeepEqual(children, expected) {
+		t.Errorf("EnumerateChildren returned %v, expected %v", children, expected)
+	}
+
+	stream, err := glib.ResourcesOpenStream("/com/github/conformal/gotk3/icons/logo.svg",
+		glib.RESOURCE_LOOKUP_FLAGS_NONE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stream.Close()
+	data, err := ioutil.ReadAll(stream)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "<svg/>" {
+		t.Errorf("Stream returned %q, expected %q", data, "<svg/>")
+	}
+
+	if _, err := glib.ResourcesLookupData("/com/github/conformal/gotk3/missing",
+		glib.RESOURCE_LOOKUP_FLAGS_NONE); err == nil {
+		t.Error("Expected error for missing resource")
+	}
+}
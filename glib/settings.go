@@ -0,0 +1,921 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0 gio-2.0
+// #define G_SETTINGS_ENABLE_BACKEND
+// #include <gio/gio.h>
+// #include <gio/gsettingsbackend.h>
+// #include "glib.go.h"
+//
+// static GSettingsBackend *
+// toGSettingsBackend(void *p)
+// {
+// 	return (G_SETTINGS_BACKEND(p));
+// }
+import "C"
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"runtime"
+	"strings"
+	"unsafe"
+)
+
+func init() {
+	tm := []TypeMarshaler{
+		// Enums
+		{Type(C.g_settings_bind_flags_get_type()), marshalSettingsBindFlags},
+
+		// Objects/Interfaces
+		{Type(C.g_settings_get_type()), marshalSettings},
+		{Type(C.g_settings_backend_get_type()), marshalSettingsBackend},
+	}
+	RegisterGValueMarshalers(tm)
+}
+
+// SettingsBindFlags is a representation of GIO's GSettingsBindFlags.
+type SettingsBindFlags int
+
+const (
+	SETTINGS_BIND_DEFAULT        SettingsBindFlags = C.G_SETTINGS_BIND_DEFAULT
+	SETTINGS_BIND_GET            SettingsBindFlags = C.G_SETTINGS_BIND_GET
+	SETTINGS_BIND_SET            SettingsBindFlags = C.G_SETTINGS_BIND_SET
+	SETTINGS_BIND_NO_SENSITIVITY SettingsBindFlags = C.G_SETTINGS_BIND_NO_SENSITIVITY
+	SETTINGS_BIND_GET_NO_CHANGES SettingsBindFlags = C.G_SETTINGS_BIND_GET_NO_CHANGES
+	SETTINGS_BIND_INVERT_BOOLEAN SettingsBindFlags = C.G_SETTINGS_BIND_INVERT_BOOLEAN
+)
+
+func marshalSettingsBindFlags(p uintptr) (interface{}, error) {
+	c := C.g_value_get_flags((*C.GValue)(unsafe.Pointer(p)))
+	return SettingsBindFlags(c), nil
+}
+
+/*
+ * GSettingsSchemaSource
+ */
+
+// SettingsSchemaSource is a representation of GIO's GSettingsSchemaSource.
+type SettingsSchemaSource struct {
+	source *C.GSettingsSchemaSource
+}
+
+// native returns a pointer to the underlying GSettingsSchemaSource.
+func (v *SettingsSchemaSource) native() *C.GSettingsSchemaSource {
+	if v == nil {
+		return nil
+	}
+	return v.source
+}
+
+// Native returns a pointer to the underlying GSettingsSchemaSource.
+func (v *SettingsSchemaSource) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func wrapSettingsSchemaSource(source *C.GSettingsSchemaSource) *SettingsSchemaSource {
+	s := &SettingsSchemaSource{source}
+	runtime.SetFinalizer(s, (*SettingsSchemaSource).unref)
+	return s
+}
+
+func (v *SettingsSchemaSource) unref() {
+	C.g_settings_schema_source_unref(v.native())
+}
+
+// SettingsSchemaSourceGetDefault is a wrapper around
+// g_settings_schema_source_get_default().  A non-nil error is returned
+// if no schemas are installed.
+func SettingsSchemaSourceGetDefault() (*SettingsSchemaSource, error) {
+	c := C.g_settings_schema_source_get_default()
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return wrapSettingsSchemaSource(C.g_settings_schema_source_ref(c)), nil
+}
+
+// SettingsSchemaSourceNewFromDirectory is a wrapper around
+// g_settings_schema_source_new_from_directory().  directory must contain
+// a gschemas.compiled file, as created by glib-compile-schemas.  parent
+// may be nil.
+func SettingsSchemaSourceNewFromDirectory(directory string, parent *SettingsSchemaSource, trusted bool) (*SettingsSchemaSource, error) {
+	cstr := C.CString(directory)
+	defer C.free(unsafe.Pointer(cstr))
+	var err *C.GError
+	c := C.g_settings_schema_source_new_from_directory((*C.gchar)(cstr),
+		parent.native(), gbool(trusted), &err)
+	if c == nil {
+		defer C.g_error_free(err)
+		return nil, errors.New(C.GoString((*C.char)(err.message)))
+	}
+	return wrapSettingsSchemaSource(c), nil
+}
+
+// Lookup is a wrapper around g_settings_schema_source_lookup().  A nil
+// SettingsSchema is returned if the schema could not be found.
+func (v *SettingsSchemaSource) Lookup(schemaID string, recursive bool) *SettingsSchema {
+	cstr := C.CString(schemaID)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.g_settings_schema_source_lookup(v.native(), (*C.gchar)(cstr),
+		gbool(recursive))
+	if c == nil {
+		return nil
+	}
+	return wrapSettingsSchema(c)
+}
+
+/*
+ * GSettingsSchema
+ */
+
+// SettingsSchema is a representation of GIO's GSettingsSchema.
+type SettingsSchema struct {
+	schema *C.GSettingsSchema
+}
+
+// native returns a pointer to the underlying GSettingsSchema.
+func (v *SettingsSchema) native() *C.GSettingsSchema {
+	if v == nil {
+		return nil
+	}
+	return v.schema
+}
+
+// Native returns a pointer to the underlying GSettingsSchema.
+func (v *SettingsSchema) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func wrapSettingsSchema(schema *C.GSettingsSchema) *SettingsSchema {
+	s := &SettingsSchema{schema}
+	runtime.SetFinalizer(s, (*SettingsSchema).unref)
+	return s
+}
+
+func (v *SettingsSchema) unref() {
+	C.g_settings_schema_unref(v.native())
+}
+
+// GetID is a wrapper around g_settings_schema_get_id().
+func (v *SettingsSchema) GetID() string {
+	c := C.g_settings_schema_get_id(v.native())
+	return C.GoString((*C.char)(c))
+}
+
+// GetPath is a wrapper around g_settings_schema_get_path().  An empty
+// string is returned for relocatable schemas.
+func (v *SettingsSchema) GetPath() string {
+	c := C.g_settings_schema_get_path(v.native())
+	if c == nil {
+		return ""
+	}
+	return C.GoString((*C.char)(c))
+}
+
+/*
+ * GSettingsBackend
+ */
+
+// SettingsBackend is a representation of GIO's GSettingsBackend.
+type SettingsBackend struct {
+	*Object
+}
+
+// native returns a pointer to the underlying GSettingsBackend.
+func (v *SettingsBackend) native() *C.GSettingsBackend {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGSettingsBackend(p)
+}
+
+// Native returns a pointer to the underlying GSettingsBackend.
+func (v *SettingsBackend) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalSettingsBackend(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	return wrapSettingsBackend(newObject(C.toGObject(unsafe.Pointer(c)))), nil
+}
+
+func wrapSettingsBackend(obj *Object) *SettingsBackend {
+	return &SettingsBackend{obj}
+}
+
+// SettingsBackendGetDefault is a wrapper around
+// g_settings_backend_get_default().
+func SettingsBackendGetDefault() (*SettingsBackend, error) {
+	c := C.g_settings_backend_get_default()
+	if c == nil {
+		return nil, errNilPtr
+	}
+	obj := newObject(C.toGObject(unsafe.Pointer(c)))
+	runtime.SetFinalizer(obj, (*Object).Unref)
+	return wrapSettingsBackend(obj), nil
+}
+
+// MemorySettingsBackendNew is a wrapper around
+// g_memory_settings_backend_new().  Settings using this backend are kept
+// in memory only and are lost when the program exits, which makes it
+// suitable for tests.
+func MemorySettingsBackendNew() (*SettingsBackend, error) {
+	c := C.g_memory_settings_backend_new()
+	if c == nil {
+		return nil, errNilPtr
+	}
+	obj := newObject(C.toGObject(unsafe.Pointer(c)))
+	runtime.SetFinalizer(obj, (*Object).Unref)
+	return wrapSettingsBackend(obj), nil
+}
+
+/*
+ * GSettings
+ */
+
+// Settings is a representation of GIO's GSettings.
+//
+// Changes to keys are reported by the "changed" signal, which may be
+// connected to for a single key by using the detailed signal name
+// "changed::key".  Callbacks take the form:
+//
+//	func(settings *glib.Settings, key string)
+type Settings struct {
+	*Object
+}
+
+// native returns a pointer to the underlying GSettings.
+func (v *Settings) native() *C.GSettings {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGSettings(p)
+}
+
+// Native returns a pointer to the underlying GSettings.
+func (v *Settings) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalSettings(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	return wrapSettings(newObject(C.toGObject(unsafe.Pointer(c)))), nil
+}
+
+func wrapSettings(obj *Object) *Settings {
+	return &Settings{obj}
+}
+
+// takeSettings wraps a GSettings returned with full transfer.
+func takeSettings(c *C.GSettings) *Settings {
+	obj := newObject(C.toGObject(unsafe.Pointer(c)))
+	runtime.SetFinalizer(obj, (*Object).Unref)
+	return wrapSettings(obj)
+}
+
+// lookupDefaultSchema returns a non-nil error if schemaID is not
+// installed.  g_settings_new() and similar functions abort the program
+// when given an unknown schema, so this is checked beforehand.
+func lookupDefaultSchema(schemaID string) error {
+	source, err := SettingsSchemaSourceGetDefault()
+	if err != nil || source.Lookup(schemaID, true) == nil {
+		return fmt.Errorf("settings schema '%s' is not installed", schemaID)
+	}
+	return nil
+}
+
+// SettingsNew is a wrapper around g_settings_new().  Unlike the C
+// function, a non-nil error is returned rather than aborting if the
+// schema is not installed.
+func SettingsNew(schemaID string) (*Settings, error) {
+	if err := lookupDefaultSchema(schemaID); err != nil {
+		return nil, err
+	}
+	cstr := C.CString(schemaID)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.g_settings_new((*C.gchar)(cstr))
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return takeSettings(c), nil
+}
+
+// SettingsNewWithPath is a wrapper around g_settings_new_with_path().
+// Unlike the C function, a non-nil error is returned rather than
+// aborting if the schema is not installed.
+func SettingsNewWithPath(schemaID, path string) (*Settings, error) {
+	if err := lookupDefaultSchema(schemaID); err != nil {
+		return nil, err
+	}
+	cstr1 := C.CString(schemaID)
+	defer C.free(unsafe.Pointer(cstr1))
+	cstr2 := C.CString(path)
+	defer C.free(unsafe.Pointer(cstr2))
+	c := C.g_settings_new_with_path((*C.gchar)(cstr1), (*C.gchar)(cstr2))
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return takeSettings(c), nil
+}
+
+// SettingsNewWithBackend is a wrapper around
+// g_settings_new_with_backend().  Unlike the C function, a non-nil error
+// is returned rather than aborting if the schema is not installed.
+func SettingsNewWithBackend(schemaID string, backend *SettingsBackend) (*Settings, error) {
+	if err := lookupDefaultSchema(schemaID); err != nil {
+		return nil, err
+	}
+	cstr := C.CString(schemaID)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.g_settings_new_with_backend((*C.gchar)(cstr), backend.native())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return takeSettings(c), nil
+}
+
+// SettingsNewFull is a wrapper around g_settings_new_full().  backend may
+// be nil to use the default backend, and path may be empty unless schema
+// is relocatable.
+func SettingsNewFull(schema *SettingsSchema, backend *SettingsBackend, path string) (*Settings, error) {
+	if schema == nil {
+		return nil, errors.New("schema must not be nil")
+	}
+	var cpath *C.gchar
+	if path != "" {
+		cstr := C.CString(path)
+		defer C.free(unsafe.Pointer(cstr))
+		cpath = (*C.gchar)(cstr)
+	}
+	c := C.g_settings_new_full(schema.native(), backend.native(), cpath)
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return takeSettings(c), nil
+}
+
+// SettingsSync is a wrapper around g_settings_sync().
+func SettingsSync() {
+	C.g_settings_sync()
+}
+
+// GetChild is a wrapper around g_settings_get_child().
+func (v *Settings) GetChild(name string) (*Settings, error) {
+	cstr := C.CString(name)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.g_settings_get_child(v.native(), (*C.gchar)(cstr))
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return takeSettings(c), nil
+}
+
+// ListKeys is a wrapper around g_settings_list_keys().
+func (v *Settings) ListKeys() []string {
+	c := C.g_settings_list_keys(v.native())
+	defer C.g_strfreev(c)
+	return goStrings(c)
+}
+
+// ListChildren is a wrapper around g_settings_list_children().
+func (v *Settings) ListChildren() []string {
+	c := C.g_settings_list_children(v.native())
+	defer C.g_strfreev(c)
+	return goStrings(c)
+}
+
+// IsWritable is a wrapper around g_settings_is_writable().
+func (v *Settings) IsWritable(key string) bool {
+	cstr := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.g_settings_is_writable(v.native(), (*C.gchar)(cstr))
+	return gobool(c)
+}
+
+// Reset is a wrapper around g_settings_reset().
+func (v *Settings) Reset(key string) {
+	cstr := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr))
+	C.g_settings_reset(v.native(), (*C.gchar)(cstr))
+}
+
+// Delay is a wrapper around g_settings_delay().
+func (v *Settings) Delay() {
+	C.g_settings_delay(v.native())
+}
+
+// Apply is a wrapper around g_settings_apply().
+func (v *Settings) Apply() {
+	C.g_settings_apply(v.native())
+}
+
+// Revert is a wrapper around g_settings_revert().
+func (v *Settings) Revert() {
+	C.g_settings_revert(v.native())
+}
+
+// GetHasUnapplied is a wrapper around g_settings_get_has_unapplied().
+func (v *Settings) GetHasUnapplied() bool {
+	c := C.g_settings_get_has_unapplied(v.native())
+	return gobool(c)
+}
+
+// GetBoolean is a wrapper around g_settings_get_boolean().
+func (v *Settings) GetBoolean(key string) bool {
+	cstr := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.g_settings_get_boolean(v.native(), (*C.gchar)(cstr))
+	return gobool(c)
+}
+
+// SetBoolean is a wrapper around g_settings_set_boolean().  false is
+// returned if the key is not writable.
+func (v *Settings) SetBoolean(key string, value bool) bool {
+	cstr := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.g_settings_set_boolean(v.native(), (*C.gchar)(cstr), gbool(value))
+	return gobool(c)
+}
+
+// GetInt is a wrapper around g_settings_get_int().
+func (v *Settings) GetInt(key string) int {
+	cstr := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.g_settings_get_int(v.native(), (*C.gchar)(cstr))
+	return int(c)
+}
+
+// SetInt is a wrapper around g_settings_set_int().  false is returned
+// if the key is not writable.
+func (v *Settings) SetInt(key string, value int) bool {
+	cstr := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.g_settings_set_int(v.native(), (*C.gchar)(cstr), C.gint(value))
+	return gobool(c)
+}
+
+// GetUint is a wrapper around g_settings_get_uint().
+func (v *Settings) GetUint(key string) uint {
+	cstr := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.g_settings_get_uint(v.native(), (*C.gchar)(cstr))
+	return uint(c)
+}
+
+// SetUint is a wrapper around g_settings_set_uint().  false is returned
+// if the key is not writable.
+func (v *Settings) SetUint(key string, value uint) bool {
+	cstr := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.g_settings_set_uint(v.native(), (*C.gchar)(cstr), C.guint(value))
+	return gobool(c)
+}
+
+// GetInt64 returns the value of a 64-bit signed integer key, in the
+// manner of g_settings_get_int64(), which requires GLib 2.50.
+func (v *Settings) GetInt64(key string) int64 {
+	cstr := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.g_settings_get_value(v.native(), (*C.gchar)(cstr))
+	defer C.g_variant_unref(c)
+	return int64(C.g_variant_get_int64(c))
+}
+
+// SetInt64 sets the value of a 64-bit signed integer key, in the manner
+// of g_settings_set_int64().  false is returned if the key is not
+// writable.
+func (v *Settings) SetInt64(key string, value int64) bool {
+	cstr := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.g_settings_set_value(v.native(), (*C.gchar)(cstr),
+		C.g_variant_new_int64(C.gint64(value)))
+	return gobool(c)
+}
+
+// GetUint64 returns the value of a 64-bit unsigned integer key, in the
+// manner of g_settings_get_uint64(), which requires GLib 2.50.
+func (v *Settings) GetUint64(key string) uint64 {
+	cstr := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.g_settings_get_value(v.native(), (*C.gchar)(cstr))
+	defer C.g_variant_unref(c)
+	return uint64(C.g_variant_get_uint64(c))
+}
+
+// SetUint64 sets the value of a 64-bit unsigned integer key, in the
+// manner of g_settings_set_uint64().  false is returned if the key is
+// not writable.
+func (v *Settings) SetUint64(key string, value uint64) bool {
+	cstr := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.g_settings_set_value(v.native(), (*C.gchar)(cstr),
+		C.g_variant_new_uint64(C.guint64(value)))
+	return gobool(c)
+}
+
+// GetDouble is a wrapper around g_settings_get_double().
+func (v *Settings) GetDouble(key string) float64 {
+	cstr := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.g_settings_get_double(v.native(), (*C.gchar)(cstr))
+	return float64(c)
+}
+
+// SetDouble is a wrapper around g_settings_set_double().  false is
+// returned if the key is not writable.
+func (v *Settings) SetDouble(key string, value float64) bool {
+	cstr := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.g_settings_set_double(v.native(), (*C.gchar)(cstr),
+		C.gdouble(value))
+	return gobool(c)
+}
+
+// GetString is a wrapper around g_settings_get_string().
+func (v *Settings) GetString(key string) string {
+	cstr := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.g_settings_get_string(v.native(), (*C.gchar)(cstr))
+	defer C.g_free(C.gpointer(c))
+	return C.GoString((*C.char)(c))
+}
+
+// SetString is a wrapper around g_settings_set_string().  false is
+// returned if the key is not writable.
+func (v *Settings) SetString(key, value string) bool {
+	cstr1 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr1))
+	cstr2 := C.CString(value)
+	defer C.free(unsafe.Pointer(cstr2))
+	c := C.g_settings_set_string(v.native(), (*C.gchar)(cstr1),
+		(*C.gchar)(cstr2))
+	return gobool(c)
+}
+
+// GetStrv is a wrapper around g_settings_get_strv().
+func (v *Settings) GetStrv(key string) []string {
+	cstr := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.g_settings_get_strv(v.native(), (*C.gchar)(cstr))
+	defer C.g_strfreev(c)
+	return goStrings(c)
+}
+
+// SetStrv is a wrapper around g_settings_set_strv().  false is returned
+// if the key is not writable.
+func (v *Settings) SetStrv(key string, value []string) bool {
+	cstr := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr))
+	cstrv := cStrings(value)
+	defer C.g_strfreev(cstrv)
+	c := C.g_settings_set_strv(v.native(), (*C.gchar)(cstr), cstrv)
+	return gobool(c)
+}
+
+// GetEnum is a wrapper around g_settings_get_enum().
+func (v *Settings) GetEnum(key string) int {
+	cstr := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.g_settings_get_enum(v.native(), (*C.gchar)(cstr))
+	return int(c)
+}
+
+// SetEnum is a wrapper around g_settings_set_enum().  false is returned
+// if the key is not writable or value is not valid for the key.
+func (v *Settings) SetEnum(key string, value int) bool {
+	cstr := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.g_settings_set_enum(v.native(), (*C.gchar)(cstr), C.gint(value))
+	return gobool(c)
+}
+
+// GetFlags is a wrapper around g_settings_get_flags().
+func (v *Settings) GetFlags(key string) uint {
+	cstr := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.g_settings_get_flags(v.native(), (*C.gchar)(cstr))
+	return uint(c)
+}
+
+// SetFlags is a wrapper around g_settings_set_flags().  false is
+// returned if the key is not writable or value is not valid for the key.
+func (v *Settings) SetFlags(key string, value uint) bool {
+	cstr := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.g_settings_set_flags(v.native(), (*C.gchar)(cstr), C.guint(value))
+	return gobool(c)
+}
+
+// Bind is a wrapper around g_settings_bind().  The property of object is
+// kept in sync with key as specified by flags.
+func (v *Settings) Bind(key string, object IObject, property string, flags SettingsBindFlags) {
+	cstr1 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr1))
+	cstr2 := C.CString(property)
+	defer C.free(unsafe.Pointer(cstr2))
+	C.g_settings_bind(v.native(), (*C.gchar)(cstr1),
+		C.gpointer(object.toGObject()), (*C.gchar)(cstr2),
+		C.GSettingsBindFlags(flags))
+}
+
+// BindWritable is a wrapper around g_settings_bind_writable().
+func (v *Settings) BindWritable(key string, object IObject, property string, inverted bool) {
+	cstr1 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr1))
+	cstr2 := C.CString(property)
+	defer C.free(unsafe.Pointer(cstr2))
+	C.g_settings_bind_writable(v.native(), (*C.gchar)(cstr1),
+		C.gpointer(object.toGObject()), (*C.gchar)(cstr2), gbool(inverted))
+}
+
+// SettingsUnbind is a wrapper around g_settings_unbind().
+func SettingsUnbind(object IObject, property string) {
+	cstr := C.CString(property)
+	defer C.free(unsafe.Pointer(cstr))
+	C.g_settings_unbind(C.gpointer(object.toGObject()), (*C.gchar)(cstr))
+}
+
+/*
+ * Go struct mapping
+ */
+
+// settingsField describes a struct field tagged with a settings key.
+type settingsField struct {
+	index int
+	key   string
+	opt   string
+}
+
+// keyType returns the GVariant type string of the value of key.
+func (v *Settings) keyType(key string) string {
+	cstr := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.g_settings_get_value(v.native(), (*C.gchar)(cstr))
+	defer C.g_variant_unref(c)
+	return C.GoString((*C.char)(C.g_variant_get_type_string(c)))
+}
+
+// settingsKeyTypes returns the GVariant types of keys which may be mapped
+// to a field of type t with tag option opt.
+func settingsKeyTypes(t reflect.Type, opt string) []string {
+	switch t.Kind() {
+	case reflect.Bool:
+		return []string{"b"}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		if opt == "enum" {
+			return []string{"s"}
+		}
+		return []string{"i", "x"}
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64:
+		if opt == "flags" {
+			return []string{"as"}
+		}
+		return []string{"u", "t"}
+
+	case reflect.Float32, reflect.Float64:
+		return []string{"d"}
+
+	case reflect.String:
+		return []string{"s"}
+
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.String {
+			return []string{"as"}
+		}
+	}
+	return nil
+}
+
+// settingsFields returns the tagged fields of the struct pointed to by v
+// after checking that each key exists in the schema of settings and that
+// its type can be held by the field.
+//
+// Fields are tagged with `gsettings:"key"` to map them to a key.  Integer
+// fields mapped to enum or flags keys must include the "enum" or "flags"
+// option, as in `gsettings:"key,enum"`.
+func (v *Settings) settingsFields(s interface{}) (reflect.Value, []settingsField, error) {
+	rv := reflect.ValueOf(s)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+		return rv, nil, errors.New("value is not a pointer to a struct")
+	}
+	rv = rv.Elem()
+
+	keys := make(map[string]bool)
+	for _, k := range v.ListKeys() {
+		keys[k] = true
+	}
+
+	var fields []settingsField
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		tag := rt.Field(i).Tag.Get("gsettings")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		f := settingsField{index: i, key: tag}
+		if j := strings.Index(tag, ","); j >= 0 {
+			f.key, f.opt = tag[:j], tag[j+1:]
+		}
+		if !keys[f.key] {
+			return rv, nil, fmt.Errorf("no key '%s' in settings schema", f.key)
+		}
+		types := settingsKeyTypes(rt.Field(i).Type, f.opt)
+		if types == nil {
+			return rv, nil, fmt.Errorf("unsupported type %s for key '%s'",
+				rt.Field(i).Type, f.key)
+		}
+		typ := v.keyType(f.key)
+		ok := false
+		for _, t := range types {
+			if t == typ {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return rv, nil, fmt.Errorf("type %s cannot hold key '%s' of type '%s'",
+				rt.Field(i).Type, f.key, typ)
+		}
+		fields = append(fields, f)
+	}
+	return rv, fields, nil
+}
+
+// GetStruct sets each field of the struct pointed to by s that is tagged
+// with `gsettings:"key"` to the current value of key.  Supported field
+// kinds are bool, signed and unsigned integers, floats, strings and
+// slices of strings.  Integer keys may be of 32 or 64 bits.  If a field
+// cannot hold the value of its key, an error is returned and the struct
+// is left unchanged.
+func (v *Settings) GetStruct(s interface{}) error {
+	rv, fields, err := v.settingsFields(s)
+	if err != nil {
+		return err
+	}
+	out := reflect.New(rv.Type()).Elem()
+	out.Set(rv)
+	for _, f := range fields {
+		fv := out.Field(f.index)
+		switch fv.Kind() {
+		case reflect.Bool:
+			fv.SetBool(v.GetBoolean(f.key))
+
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+			reflect.Int64:
+			var n int64
+			switch {
+			case f.opt == "enum":
+				n = int64(v.GetEnum(f.key))
+			case v.keyType(f.key) == "x":
+				n = v.GetInt64(f.key)
+			default:
+				n = int64(v.GetInt(f.key))
+			}
+			if fv.OverflowInt(n) {
+				return fmt.Errorf("value %d of key '%s' overflows %s",
+					n, f.key, fv.Type())
+			}
+			fv.SetInt(n)
+
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+			reflect.Uint64:
+			var n uint64
+			switch {
+			case f.opt == "flags":
+				n = uint64(v.GetFlags(f.key))
+			case v.keyType(f.key) == "t":
+				n = v.GetUint64(f.key)
+			default:
+				n = uint64(v.GetUint(f.key))
+			}
+			if fv.OverflowUint(n) {
+				return fmt.Errorf("value %d of key '%s' overflows %s",
+					n, f.key, fv.Type())
+			}
+			fv.SetUint(n)
+
+		case reflect.Float32, reflect.Float64:
+			fv.SetFloat(v.GetDouble(f.key))
+
+		case reflect.String:
+			fv.SetString(v.GetString(f.key))
+
+		case reflect.Slice:
+			strs := v.GetStrv(f.key)
+			sv := reflect.MakeSlice(fv.Type(), len(strs), len(strs))
+			for i := range strs {
+				sv.Index(i).SetString(strs[i])
+			}
+			fv.Set(sv)
+		}
+	}
+	rv.Set(out)
+	return nil
+}
+
+// SetStruct writes each field of the struct pointed to by s that is
+// tagged with `gsettings:"key"` to key.  See GetStruct for the supported
+// field kinds.  All fields are checked before any key is written, so an
+// error for a field of the wrong type, a value out of range for its key
+// or a key which is not writable leaves the settings unchanged.  To change all keys at once, call Delay
+// before and Apply after SetStruct.
+func (v *Settings) SetStruct(s interface{}) error {
+	rv, fields, err := v.settingsFields(s)
+	if err != nil {
+		return err
+	}
+
+	set := make([]func() bool, len(fields))
+	for i, f := range fields {
+		key := f.key
+		if !v.IsWritable(key) {
+			return fmt.Errorf("key '%s' is not writable", key)
+		}
+		fv := rv.Field(f.index)
+		switch fv.Kind() {
+		case reflect.Bool:
+			b := fv.Bool()
+			set[i] = func() bool { return v.SetBoolean(key, b) }
+
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+			reflect.Int64:
+			n := fv.Int()
+			if f.opt == "enum" || v.keyType(key) == "i" {
+				if n < math.MinInt32 || n > math.MaxInt32 {
+					return fmt.Errorf("value %d out of range for key '%s'",
+						n, key)
+				}
+			}
+			switch {
+			case f.opt == "enum":
+				set[i] = func() bool { return v.SetEnum(key, int(n)) }
+			case v.keyType(key) == "x":
+				set[i] = func() bool { return v.SetInt64(key, n) }
+			default:
+				set[i] = func() bool { return v.SetInt(key, int(n)) }
+			}
+
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+			reflect.Uint64:
+			n := fv.Uint()
+			if f.opt == "flags" || v.keyType(key) == "u" {
+				if n > math.MaxUint32 {
+					return fmt.Errorf("value %d out of range for key '%s'",
+						n, key)
+				}
+			}
+			switch {
+			case f.opt == "flags":
+				set[i] = func() bool { return v.SetFlags(key, uint(n)) }
+			case v.keyType(key) == "t":
+				set[i] = func() bool { return v.SetUint64(key, n) }
+			default:
+				set[i] = func() bool { return v.SetUint(key, uint(n)) }
+			}
+
+		case reflect.Float32, reflect.Float64:
+			d := fv.Float()
+			set[i] = func() bool { return v.SetDouble(key, d) }
+
+		case reflect.String:
+			str := fv.String()
+			set[i] = func() bool { return v.SetString(key, str) }
+
+		case reflect.Slice:
+			strs := make([]string, fv.Len())
+			for j := range strs {
+				strs[j] = fv.Index(j).String()
+			}
+			set[i] = func() bool { return v.SetStrv(key, strs) }
+		}
+	}
+
+	for i, f := range fields {
+		if !set[i]() {
+			return fmt.Errorf("unable to set key '%s'", f.key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,275 @@
+package glib_test
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/conformal/gotk3/glib"
+	"github.com/conformal/gotk3/gtk"
+)
+
+const testSchemaID = "com.github.conformal.gotk3.Test"
+
+const testSchemaXML = `<?xml version="1.0" encoding="UTF-8"?>
+<schemalist>
+  <schema id="com.github.conformal.gotk3.Test" path="/com/github/conformal/gotk3/test/">
+    <key name="enabled" type="b">
+      <default>false</default>
+    </key>
+    <key name="count" type="i">
+      <default>3</default>
+    </key>
+    <key name="name" type="s">
+      <default>'gotk3'</default>
+    </key>
+    <key name="ratio" type="d">
+      <default>0.5</default>
+    </key>
+    <key name="tags" type="as">
+      <default>[]</default>
+    </key>
+    <key name="size" type="x">
+      <default>0</default>
+    </key>
+    <key name="limit" type="t">
+      <default>0</default>
+    </key>
+  </schema>
+</schemalist>
+`
+
+// newTestSettings compiles the test schema into a temporary directory and
+// returns Settings for it using the memory backend, so tests neither
+// require the schema to be installed nor touch dconf.  The returned func
+// removes the temporary directory.
+func newTestSettings(t *testing.T) (*glib.Settings, func()) {
+	compiler, err := exec.LookPath("glib-compile-schemas")
+	if err != nil {
+		t.Skip("glib-compile-schemas not found")
+	}
+
+	dir, err := ioutil.TempDir("", "gotk3-schemas")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cleanup := func() { os.RemoveAll(dir) }
+
+	xml := filepath.Join(dir, testSchemaID+".gschema.xml")
+	if err := ioutil.WriteFile(xml, []byte(testSchemaXML), 0644); err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+	if out, err := exec.Command(compiler, dir).CombinedOutput(); err != nil {
+		cleanup()
+		t.Fatalf("glib-compile-schemas: %v: %s", err, out)
+	}
+
+	source, err := glib.SettingsSchemaSourceNewFromDirectory(dir, nil, true)
+	if err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+	schema := source.Lookup(testSchemaID, false)
+	if schema == nil {
+		cleanup()
+		t.Fatal("compiled test schema not found")
+	}
+	backend, err := glib.MemorySettingsBackendNew()
+	if err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+	settings, err := glib.SettingsNewFull(schema, backend, "")
+	if err != nil {
+		cleanup()
+		t.Fatal(err)
+	}
+	return settings, cleanup
+}
+
+func TestSettingsGetSet(t *testing.T) {
+	settings, cleanup := newTestSettings(t)
+	defer cleanup()
+
+	if settings.GetInt("count") != 3 {
+		t.Error("Expected default value of 3 for count")
+	}
+	if !settings.SetInt("count", 7) || settings.GetInt("count") != 7 {
+		t.Error("Could not set or get count")
+	}
+	if !settings.SetBoolean("enabled", true) || !settings.GetBoolean("enabled") {
+		t.Error("Could not set or get enabled")
+	}
+	if !settings.SetString("name", "changed") || settings.GetString("name") != "changed" {
+		t.Error("Could not set or get name")
+	}
+	if !settings.SetDouble("ratio", 0.25) || settings.GetDouble("ratio") != 0.25 {
+		t.Error("Could not set or get ratio")
+	}
+	tags := []string{"a", "b"}
+	if !settings.SetStrv("tags", tags) || !reflect.DeepEqual(settings.GetStrv("tags"), tags) {
+		t.Error("Could not set or get tags")
+	}
+
+	settings.Reset("count")
+	if settings.GetInt("count") != 3 {
+		t.Error("Reset did not restore default value of count")
+	}
+}
+
+func TestSettingsDelayApplyRevert(t *testing.T) {
+	settings, cleanup := newTestSettings(t)
+	defer cleanup()
+
+	settings.Delay()
+	settings.SetInt("count", 10)
+	if !settings.GetHasUnapplied() {
+		t.Error("Expected unapplied changes after Delay")
+	}
+	settings.Revert()
+	if settings.GetInt("count") != 3 {
+		t.Error("Revert did not discard change to count")
+	}
+
+	settings.SetInt("count", 11)
+	settings.Apply()
+	if settings.GetHasUnapplied() || settings.GetInt("count") != 11 {
+		t.Error("Apply did not write change to count")
+	}
+}
+
+func TestSettingsChanged(t *testing.T) {
+	settings, cleanup := newTestSettings(t)
+	defer cleanup()
+
+	var changed string
+	settings.Connect("changed::name", func(s *glib.Settings, key string) {
+		changed = key
+	})
+	settings.SetString("name", "notify")
+	if changed != "name" {
+		t.Error("changed signal not emitted for name")
+	}
+}
+
+func TestSettingsStruct(t *testing.T) {
+	settings, cleanup := newTestSettings(t)
+	defer cleanup()
+
+	type prefs struct {
+		Enabled bool     `gsettings:"enabled"`
+		Count   int      `gsettings:"count"`
+		Name    string   `gsettings:"name"`
+		Ratio   float64  `gsettings:"ratio"`
+		Tags    []string `gsettings:"tags"`
+		Ignored int
+	}
+
+	in := prefs{true, 42, "struct", 0.75, []string{"x"}, 1}
+	if err := settings.SetStruct(&in); err != nil {
+		t.Fatal(err)
+	}
+	var out prefs
+	if err := settings.GetStruct(&out); err != nil {
+		t.Fatal(err)
+	}
+	in.Ignored = 0
+	if !reflect.DeepEqual(in, out) {
+		t.Errorf("GetStruct returned %+v, expected %+v", out, in)
+	}
+
+	var bad struct {
+		Missing string `gsettings:"missing"`
+	}
+	if err := settings.GetStruct(&bad); err == nil {
+		t.Error("Expected error for key missing from schema")
+	}
+}
+
+func TestSettingsStruct64(t *testing.T) {
+	settings, cleanup := newTestSettings(t)
+	defer cleanup()
+
+	type sizes struct {
+		Size  int64  `gsettings:"size"`
+		Limit uint64 `gsettings:"limit"`
+	}
+
+	in := sizes{-1 << 40, 1<<63 + 1}
+	if err := settings.SetStruct(&in); err != nil {
+		t.Fatal(err)
+	}
+	if n := settings.GetInt64("size"); n != in.Size {
+		t.Errorf("GetInt64 returned %d", n)
+	}
+	var out sizes
+	if err := settings.GetStruct(&out); err != nil {
+		t.Fatal(err)
+	}
+	if out != in {
+		t.Errorf("GetStruct returned %+v, expected %+v", out, in)
+	}
+}
+
+func TestSettingsStructRange(t *testing.T) {
+	settings, cleanup := newTestSettings(t)
+	defer cleanup()
+
+	over := struct {
+		Enabled bool  `gsettings:"enabled"`
+		Count   int64 `gsettings:"count"`
+	}{true, 1 << 40}
+	if err := settings.SetStruct(&over); err == nil {
+		t.Error("Expected error for value out of range")
+	}
+	if settings.GetBoolean("enabled") {
+		t.Error("SetStruct wrote keys before failing")
+	}
+
+	settings.SetInt("count", 300)
+	small := struct {
+		Count int8 `gsettings:"count"`
+	}{7}
+	if err := settings.GetStruct(&small); err == nil {
+		t.Error("Expected error for value overflowing field")
+	}
+	if small.Count != 7 {
+		t.Error("GetStruct changed struct before failing")
+	}
+
+	var wrong struct {
+		Count string `gsettings:"count"`
+	}
+	if err := settings.GetStruct(&wrong); err == nil {
+		t.Error("Expected error for field of wrong type")
+	}
+}
+
+func TestSettingsBind(t *testing.T) {
+	settings, cleanup := newTestSettings(t)
+	defer cleanup()
+
+	entry, err := gtk.EntryNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	settings.Bind("name", entry, "text", glib.SETTINGS_BIND_DEFAULT)
+
+	if text, _ := entry.GetText(); text != "gotk3" {
+		t.Errorf("Bound property is %q, expected %q", text, "gotk3")
+	}
+	entry.SetText("from widget")
+	if settings.GetString("name") != "from widget" {
+		t.Error("Property change not written to settings")
+	}
+	settings.SetString("name", "from settings")
+	if text, _ := entry.GetText(); text != "from settings" {
+		t.Error("Settings change not written to property")
+	}
+
+	glib.SettingsUnbind(entry, "text")
+}
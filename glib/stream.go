@@ -0,0 +1,202 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0 gio-2.0
+// #include <gio/gio.h>
+// #include "glib.go.h"
+import "C"
+import (
+	"errors"
+	"io"
+	"runtime"
+	"unsafe"
+)
+
+func init() {
+	tm := []TypeMarshaler{
+		// Objects/Interfaces
+		{Type(C.g_input_stream_get_type()), marshalInputStream},
+		{Type(C.g_output_stream_get_type()), marshalOutputStream},
+	}
+	RegisterGValueMarshalers(tm)
+}
+
+/*
+ * GInputStream
+ */
+
+// InputStream is a representation of GIO's GInputStream.  InputStream
+// implements io.Reader and io.Closer by performing blocking reads.
+type InputStream struct {
+	*Object
+}
+
+// native returns a pointer to the underlying GInputStream.
+func (v *InputStream) native() *C.GInputStream {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGInputStream(p)
+}
+
+// Native returns a pointer to the underlying GInputStream.
+func (v *InputStream) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalInputStream(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	return wrapInputStream(newObject(C.toGObject(unsafe.Pointer(c)))), nil
+}
+
+func wrapInputStream(obj *Object) *InputStream {
+	return &InputStream{obj}
+}
+
+// takeInputStream wraps a GInputStream returned with full transfer.
+func takeInputStream(c *C.GInputStream) *InputStream {
+	obj := newObject(C.toGObject(unsafe.Pointer(c)))
+	runtime.SetFinalizer(obj, (*Object).Unref)
+	return wrapInputStream(obj)
+}
+
+// Read is a wrapper around g_input_stream_read().  io.EOF is returned
+// once the end of the stream has been reached.
+func (v *InputStream) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	var err *C.GError
+	n := C.g_input_stream_read(v.native(), unsafe.Pointer(&p[0]),
+		C.gsize(len(p)), nil, &err)
+	if n < 0 {
+		defer C.g_error_free(err)
+		return 0, errors.New(C.GoString((*C.char)(err.message)))
+	}
+	if n == 0 {
+		return 0, io.EOF
+	}
+	return int(n), nil
+}
+
+// Skip is a wrapper around g_input_stream_skip().
+func (v *InputStream) Skip(count int64) (int64, error) {
+	var err *C.GError
+	n := C.g_input_stream_skip(v.native(), C.gsize(count), nil, &err)
+	if n < 0 {
+		defer C.g_error_free(err)
+		return 0, errors.New(C.GoString((*C.char)(err.message)))
+	}
+	return int64(n), nil
+}
+
+// Close is a wrapper around g_input_stream_close().
+func (v *InputStream) Close() error {
+	var err *C.GError
+	c := C.g_input_stream_close(v.native(), nil, &err)
+	if !gobool(c) {
+		defer C.g_error_free(err)
+		return errors.New(C.GoString((*C.char)(err.message)))
+	}
+	return nil
+}
+
+// IsClosed is a wrapper around g_input_stream_is_closed().
+func (v *InputStream) IsClosed() bool {
+	c := C.g_input_stream_is_closed(v.native())
+	return gobool(c)
+}
+
+/*
+ * GOutputStream
+ */
+
+// OutputStream is a representation of GIO's GOutputStream.  OutputStream
+// implements io.Writer and io.Closer by performing blocking writes.
+type OutputStream struct {
+	*Object
+}
+
+// native returns a pointer to the underlying GOutputStream.
+func (v *OutputStream) native() *C.GOutputStream {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGOutputStream(p)
+}
+
+// Native returns a pointer to the underlying GOutputStream.
+func (v *OutputStream) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalOutputStream(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	return wrapOutputStream(newObject(C.toGObject(unsafe.Pointer(c)))), nil
+}
+
+func wrapOutputStream(obj *Object) *OutputStream {
+	return &OutputStream{obj}
+}
+
+// Write is a wrapper around g_output_stream_write_all().  As required by
+// io.Writer, a non-nil error is returned if not all of p was written.
+func (v *OutputStream) Write(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	var n C.gsize
+	var err *C.GError
+	c := C.g_output_stream_write_all(v.native(), unsafe.Pointer(&p[0]),
+		C.gsize(len(p)), &n, nil, &err)
+	if !gobool(c) {
+		defer C.g_error_free(err)
+		return int(n), errors.New(C.GoString((*C.char)(err.message)))
+	}
+	return int(n), nil
+}
+
+// Flush is a wrapper around g_output_stream_flush().
+func (v *OutputStream) Flush() error {
+	var err *C.GError
+	c := C.g_output_stream_flush(v.native(), nil, &err)
+	if !gobool(c) {
+		defer C.g_error_free(err)
+		return errors.New(C.GoString((*C.char)(err.message)))
+	}
+	return nil
+}
+
+// Close is a wrapper around g_output_stream_close().
+func (v *OutputStream) Close() error {
+	var err *C.GError
+	c := C.g_output_stream_close(v.native(), nil, &err)
+	if !gobool(c) {
+		defer C.g_error_free(err)
+		return errors.New(C.GoString((*C.char)(err.message)))
+	}
+	return nil
+}
+
+// IsClosed is a wrapper around g_output_stream_is_closed().
+func (v *OutputStream) IsClosed() bool {
+	c := C.g_output_stream_is_closed(v.native())
+	return gobool(c)
+}
@@ -0,0 +1,468 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+// This file includes wrapers for symbols included since GLib 2.40, and
+// and should not be included in a build intended to target any older GLib
+// versions.  To target an older build, such as 2.38, use
+// 'go build -tags glib_2_38'.  Otherwise, if no build tags are used, GLib
+// 2.40 is assumed and this file is built.
+// +build !glib_2_36,!glib_2_38
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0 gio-2.0
+// #include <gio/gio.h>
+// #include "glib.go.h"
+//
+// static GSubprocessLauncher *
+// toGSubprocessLauncher(void *p)
+// {
+// 	return (G_SUBPROCESS_LAUNCHER(p));
+// }
+//
+// static GSubprocess *
+// toGSubprocess(void *p)
+// {
+// 	return (G_SUBPROCESS(p));
+// }
+//
+// static void
+// _g_subprocess_wait_async(GSubprocess *subprocess, gpointer user_data)
+// {
+// 	g_subprocess_wait_async(subprocess, NULL,
+// 	    (GAsyncReadyCallback)(goAsyncReadyCallback), user_data);
+// }
+//
+// static void
+// _g_subprocess_wait_check_async(GSubprocess *subprocess, gpointer user_data)
+// {
+// 	g_subprocess_wait_check_async(subprocess, NULL,
+// 	    (GAsyncReadyCallback)(goAsyncReadyCallback), user_data);
+// }
+//
+// static void
+// _g_subprocess_communicate_utf8_async(GSubprocess *subprocess,
+//     const char *stdin_buf, gpointer user_data)
+// {
+// 	g_subprocess_communicate_utf8_async(subprocess, stdin_buf, NULL,
+// 	    (GAsyncReadyCallback)(goAsyncReadyCallback), user_data);
+// }
+import "C"
+import (
+	"errors"
+	"runtime"
+	"unsafe"
+)
+
+func init() {
+	tm := []TypeMarshaler{
+		// Enums
+		{Type(C.g_subprocess_flags_get_type()), marshalSubprocessFlags},
+
+		// Objects/Interfaces
+		{Type(C.g_subprocess_launcher_get_type()), marshalSubprocessLauncher},
+		{Type(C.g_subprocess_get_type()), marshalSubprocess},
+	}
+	RegisterGValueMarshalers(tm)
+}
+
+// SubprocessFlags is a representation of GIO's GSubprocessFlags.
+type SubprocessFlags int
+
+const (
+	SUBPROCESS_FLAGS_NONE           SubprocessFlags = C.G_SUBPROCESS_FLAGS_NONE
+	SUBPROCESS_FLAGS_STDIN_PIPE     SubprocessFlags = C.G_SUBPROCESS_FLAGS_STDIN_PIPE
+	SUBPROCESS_FLAGS_STDIN_INHERIT  SubprocessFlags = C.G_SUBPROCESS_FLAGS_STDIN_INHERIT
+	SUBPROCESS_FLAGS_STDOUT_PIPE    SubprocessFlags = C.G_SUBPROCESS_FLAGS_STDOUT_PIPE
+	SUBPROCESS_FLAGS_STDOUT_SILENCE SubprocessFlags = C.G_SUBPROCESS_FLAGS_STDOUT_SILENCE
+	SUBPROCESS_FLAGS_STDERR_PIPE    SubprocessFlags = C.G_SUBPROCESS_FLAGS_STDERR_PIPE
+	SUBPROCESS_FLAGS_STDERR_SILENCE SubprocessFlags = C.G_SUBPROCESS_FLAGS_STDERR_SILENCE
+	SUBPROCESS_FLAGS_STDERR_MERGE   SubprocessFlags = C.G_SUBPROCESS_FLAGS_STDERR_MERGE
+	SUBPROCESS_FLAGS_INHERIT_FDS    SubprocessFlags = C.G_SUBPROCESS_FLAGS_INHERIT_FDS
+)
+
+func marshalSubprocessFlags(p uintptr) (interface{}, error) {
+	c := C.g_value_get_flags((*C.GValue)(unsafe.Pointer(p)))
+	return SubprocessFlags(c), nil
+}
+
+/*
+ * GSubprocessLauncher
+ */
+
+// SubprocessLauncher is a representation of GIO's GSubprocessLauncher.
+type SubprocessLauncher struct {
+	*Object
+}
+
+// native returns a pointer to the underlying GSubprocessLauncher.
+func (v *SubprocessLauncher) native() *C.GSubprocessLauncher {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGSubprocessLauncher(p)
+}
+
+// Native returns a pointer to the underlying GSubprocessLauncher.
+func (v *SubprocessLauncher) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalSubprocessLauncher(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	return wrapSubprocessLauncher(newObject(C.toGObject(unsafe.Pointer(c)))), nil
+}
+
+func wrapSubprocessLauncher(obj *Object) *SubprocessLauncher {
+	return &SubprocessLauncher{obj}
+}
+
+// SubprocessLauncherNew is a wrapper around g_subprocess_launcher_new().
+// The launcher starts out with a copy of the environment of the calling
+// process.
+func SubprocessLauncherNew(flags SubprocessFlags) (*SubprocessLauncher, error) {
+	c := C.g_subprocess_launcher_new(C.GSubprocessFlags(flags))
+	if c == nil {
+		return nil, errNilPtr
+	}
+	obj := newObject(C.toGObject(unsafe.Pointer(c)))
+	runtime.SetFinalizer(obj, (*Object).Unref)
+	return wrapSubprocessLauncher(obj), nil
+}
+
+// SetFlags is a wrapper around g_subprocess_launcher_set_flags().
+func (v *SubprocessLauncher) SetFlags(flags SubprocessFlags) {
+	C.g_subprocess_launcher_set_flags(v.native(), C.GSubprocessFlags(flags))
+}
+
+// SetEnviron is a wrapper around g_subprocess_launcher_set_environ().
+// Each element of env has the form "NAME=value".
+func (v *SubprocessLauncher) SetEnviron(env []string) {
+	cenv := cStrings(env)
+	defer C.g_strfreev(cenv)
+	C.g_subprocess_launcher_set_environ(v.native(), cenv)
+}
+
+// Setenv is a wrapper around g_subprocess_launcher_setenv().
+func (v *SubprocessLauncher) Setenv(variable, value string, overwrite bool) {
+	cvar := C.CString(variable)
+	defer C.free(unsafe.Pointer(cvar))
+	cval := C.CString(value)
+	defer C.free(unsafe.Pointer(cval))
+	C.g_subprocess_launcher_setenv(v.native(), (*C.gchar)(cvar),
+		(*C.gchar)(cval), gbool(overwrite))
+}
+
+// Unsetenv is a wrapper around g_subprocess_launcher_unsetenv().
+func (v *SubprocessLauncher) Unsetenv(variable string) {
+	cstr := C.CString(variable)
+	defer C.free(unsafe.Pointer(cstr))
+	C.g_subprocess_launcher_unsetenv(v.native(), (*C.gchar)(cstr))
+}
+
+// Getenv is a wrapper around g_subprocess_launcher_getenv().  A non-nil
+// error is returned if variable is not set.
+func (v *SubprocessLauncher) Getenv(variable string) (string, error) {
+	cstr := C.CString(variable)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.g_subprocess_launcher_getenv(v.native(), (*C.gchar)(cstr))
+	if c == nil {
+		return "", errNilPtr
+	}
+	return C.GoString((*C.char)(c)), nil
+}
+
+// SetCwd is a wrapper around g_subprocess_launcher_set_cwd().
+func (v *SubprocessLauncher) SetCwd(cwd string) {
+	cstr := C.CString(cwd)
+	defer C.free(unsafe.Pointer(cstr))
+	C.g_subprocess_launcher_set_cwd(v.native(), (*C.gchar)(cstr))
+}
+
+// Spawnv is a wrapper around g_subprocess_launcher_spawnv().  argv[0] is
+// looked up in the PATH of the launcher's environment.
+func (v *SubprocessLauncher) Spawnv(argv []string) (*Subprocess, error) {
+	if len(argv) == 0 {
+		return nil, errors.New("argv is empty")
+	}
+	cargv := cStrings(argv)
+	defer C.g_strfreev(cargv)
+	var err *C.GError
+	c := C.g_subprocess_launcher_spawnv(v.native(), cargv, &err)
+	if c == nil {
+		defer C.g_error_free(err)
+		return nil, errors.New(C.GoString((*C.char)(err.message)))
+	}
+	return takeSubprocess(c), nil
+}
+
+/*
+ * GSubprocess
+ */
+
+// Subprocess is a representation of GIO's GSubprocess.
+//
+// Methods ending in Async return immediately and call their callback
+// from the main loop once the subprocess has exited, so they may be used
+// from GTK signal handlers without blocking the UI.
+type Subprocess struct {
+	*Object
+}
+
+// native returns a pointer to the underlying GSubprocess.
+func (v *Subprocess) native() *C.GSubprocess {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toGSubprocess(p)
+}
+
+// Native returns a pointer to the underlying GSubprocess.
+func (v *Subprocess) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalSubprocess(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	return wrapSubprocess(newObject(C.toGObject(unsafe.Pointer(c)))), nil
+}
+
+func wrapSubprocess(obj *Object) *Subprocess {
+	return &Subprocess{obj}
+}
+
+// takeSubprocess wraps a GSubprocess returned with full transfer.
+func takeSubprocess(c *C.GSubprocess) *Subprocess {
+	obj := newObject(C.toGObject(unsafe.Pointer(c)))
+	runtime.SetFinalizer(obj, (*Object).Unref)
+	return wrapSubprocess(obj)
+}
+
+// SubprocessNew is a wrapper around g_subprocess_newv().  argv[0] is
+// looked up in the PATH of the calling process.
+func SubprocessNew(argv []string, flags SubprocessFlags) (*Subprocess, error) {
+	if len(argv) == 0 {
+		return nil, errors.New("argv is empty")
+	}
+	cargv := cStrings(argv)
+	defer C.g_strfreev(cargv)
+	var err *C.GError
+	c := C.g_subprocess_newv(cargv, C.GSubprocessFlags(flags), &err)
+	if c == nil {
+		defer C.g_error_free(err)
+		return nil, errors.New(C.GoString((*C.char)(err.message)))
+	}
+	return takeSubprocess(c), nil
+}
+
+// GetIdentifier is a wrapper around g_subprocess_get_identifier().  On
+// UNIX, this is the process ID.  An empty string is returned once the
+// process has exited.
+func (v *Subprocess) GetIdentifier() string {
+	c := C.g_subprocess_get_identifier(v.native())
+	return C.GoString((*C.char)(c))
+}
+
+// GetStdinPipe is a wrapper around g_subprocess_get_stdin_pipe().  nil
+// is returned unless the subprocess was created with
+// SUBPROCESS_FLAGS_STDIN_PIPE.  Close the pipe to signal end of input.
+func (v *Subprocess) GetStdinPipe() *OutputStream {
+	c := C.g_subprocess_get_stdin_pipe(v.native())
+	if c == nil {
+		return nil
+	}
+	obj := newObject(C.toGObject(unsafe.Pointer(c)))
+	obj.Ref()
+	runtime.SetFinalizer(obj, (*Object).Unref)
+	return wrapOutputStream(obj)
+}
+
+// GetStdoutPipe is a wrapper around g_subprocess_get_stdout_pipe().  nil
+// is returned unless the subprocess was created with
+// SUBPROCESS_FLAGS_STDOUT_PIPE.
+func (v *Subprocess) GetStdoutPipe() *InputStream {
+	c := C.g_subprocess_get_stdout_pipe(v.native())
+	if c == nil {
+		return nil
+	}
+	obj := newObject(C.toGObject(unsafe.Pointer(c)))
+	obj.Ref()
+	runtime.SetFinalizer(obj, (*Object).Unref)
+	return wrapInputStream(obj)
+}
+
+// GetStderrPipe is a wrapper around g_subprocess_get_stderr_pipe().  nil
+// is returned unless the subprocess was created with
+// SUBPROCESS_FLAGS_STDERR_PIPE.
+func (v *Subprocess) GetStderrPipe() *InputStream {
+	c := C.g_subprocess_get_stderr_pipe(v.native())
+	if c == nil {
+		return nil
+	}
+	obj := newObject(C.toGObject(unsafe.Pointer(c)))
+	obj.Ref()
+	runtime.SetFinalizer(obj, (*Object).Unref)
+	return wrapInputStream(obj)
+}
+
+// Wait is a wrapper around g_subprocess_wait().  It blocks until the
+// subprocess exits.  A nil error does not indicate that the subprocess
+// was successful; see WaitCheck.
+func (v *Subprocess) Wait() error {
+	var err *C.GError
+	c := C.g_subprocess_wait(v.native(), nil, &err)
+	if !gobool(c) {
+		defer C.g_error_free(err)
+		return errors.New(C.GoString((*C.char)(err.message)))
+	}
+	return nil
+}
+
+// WaitCheck is a wrapper around g_subprocess_wait_check().  It blocks
+// until the subprocess exits, and returns a non-nil error if it did not
+// exit successfully.
+func (v *Subprocess) WaitCheck() error {
+	var err *C.GError
+	c := C.g_subprocess_wait_check(v.native(), nil, &err)
+	if !gobool(c) {
+		defer C.g_error_free(err)
+		return errors.New(C.GoString((*C.char)(err.message)))
+	}
+	return nil
+}
+
+// WaitAsync is a wrapper around g_subprocess_wait_async().  f is called
+// from the main loop once the subprocess exits.
+func (v *Subprocess) WaitAsync(f func(err error)) {
+	data := registerCallback(asyncReadyCallback(func(res *C.GAsyncResult) {
+		var err *C.GError
+		c := C.g_subprocess_wait_finish(v.native(), res, &err)
+		if !gobool(c) {
+			defer C.g_error_free(err)
+			f(errors.New(C.GoString((*C.char)(err.message))))
+			return
+		}
+		f(nil)
+	}))
+	C._g_subprocess_wait_async(v.native(), data)
+}
+
+// WaitCheckAsync is a wrapper around g_subprocess_wait_check_async().  f
+// is called from the main loop once the subprocess exits, with a non-nil
+// error if it did not exit successfully.  The exit status may then be
+// read with GetExitStatus.
+func (v *Subprocess) WaitCheckAsync(f func(err error)) {
+	data := registerCallback(asyncReadyCallback(func(res *C.GAsyncResult) {
+		var err *C.GError
+		c := C.g_subprocess_wait_check_finish(v.native(), res, &err)
+		if !gobool(c) {
+			defer C.g_error_free(err)
+			f(errors.New(C.GoString((*C.char)(err.message))))
+			return
+		}
+		f(nil)
+	}))
+	C._g_subprocess_wait_check_async(v.native(), data)
+}
+
+// CommunicateUTF8 is a wrapper around g_subprocess_communicate_utf8().
+// stdin is written to the subprocess and its stdin is closed, and the
+// output is collected until the subprocess exits.  stdin must be empty
+// unless the subprocess was created with SUBPROCESS_FLAGS_STDIN_PIPE,
+// and output is only collected from pipes.  It blocks until the
+// subprocess exits.
+func (v *Subprocess) CommunicateUTF8(stdin string) (stdout, stderr string, e error) {
+	cstdin := cStringOrNil(stdin)
+	defer C.free(unsafe.Pointer(cstdin))
+	var cstdout, cstderr *C.char
+	var err *C.GError
+	c := C.g_subprocess_communicate_utf8(v.native(), (*C.char)(cstdin),
+		nil, &cstdout, &cstderr, &err)
+	defer C.g_free(C.gpointer(cstdout))
+	defer C.g_free(C.gpointer(cstderr))
+	if !gobool(c) {
+		defer C.g_error_free(err)
+		return "", "", errors.New(C.GoString((*C.char)(err.message)))
+	}
+	return C.GoString(cstdout), C.GoString(cstderr), nil
+}
+
+// CommunicateUTF8Async is a wrapper around
+// g_subprocess_communicate_utf8_async().  It behaves like CommunicateUTF8,
+// but returns immediately and calls f from the main loop once the
+// subprocess exits.
+func (v *Subprocess) CommunicateUTF8Async(stdin string, f func(stdout, stderr string, err error)) {
+	data := registerCallback(asyncReadyCallback(func(res *C.GAsyncResult) {
+		var cstdout, cstderr *C.char
+		var err *C.GError
+		c := C.g_subprocess_communicate_utf8_finish(v.native(), res,
+			&cstdout, &cstderr, &err)
+		defer C.g_free(C.gpointer(cstdout))
+		defer C.g_free(C.gpointer(cstderr))
+		if !gobool(c) {
+			defer C.g_error_free(err)
+			f("", "", errors.New(C.GoString((*C.char)(err.message))))
+			return
+		}
+		f(C.GoString(cstdout), C.GoString(cstderr), nil)
+	}))
+	// The input is copied before this call returns.
+	cstdin := cStringOrNil(stdin)
+	defer C.free(unsafe.Pointer(cstdin))
+	C._g_subprocess_communicate_utf8_async(v.native(), (*C.char)(cstdin), data)
+}
+
+// ForceExit is a wrapper around g_subprocess_force_exit().
+func (v *Subprocess) ForceExit() {
+	C.g_subprocess_force_exit(v.native())
+}
+
+// GetSuccessful is a wrapper around g_subprocess_get_successful().  It
+// may only be called after the subprocess has exited.
+func (v *Subprocess) GetSuccessful() bool {
+	c := C.g_subprocess_get_successful(v.native())
+	return gobool(c)
+}
+
+// GetIfExited is a wrapper around g_subprocess_get_if_exited().  It may
+// only be called after the subprocess has exited.
+func (v *Subprocess) GetIfExited() bool {
+	c := C.g_subprocess_get_if_exited(v.native())
+	return gobool(c)
+}
+
+// GetExitStatus is a wrapper around g_subprocess_get_exit_status().  It
+// may only be called if GetIfExited returns true.
+func (v *Subprocess) GetExitStatus() int {
+	c := C.g_subprocess_get_exit_status(v.native())
+	return int(c)
+}
+
+// GetIfSignaled is a wrapper around g_subprocess_get_if_signaled().  It
+// may only be called after the subprocess has exited.
+func (v *Subprocess) GetIfSignaled() bool {
+	c := C.g_subprocess_get_if_signaled(v.native())
+	return gobool(c)
+}
+
+// GetTermSig is a wrapper around g_subprocess_get_term_sig().  It may
+// only be called if GetIfSignaled returns true.
+func (v *Subprocess) GetTermSig() int {
+	c := C.g_subprocess_get_term_sig(v.native())
+	return int(c)
+}
@@ -0,0 +1,109 @@
+// +build !glib_2_36,!glib_2_38
+
+package glib_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/conformal/gotk3/glib"
+	"github.com/conformal/gotk3/gtk"
+)
+
+func TestSubprocessPipes(t *testing.T) {
+	flags := glib.SUBPROCESS_FLAGS_STDIN_PIPE | glib.SUBPROCESS_FLAGS_STDOUT_PIPE
+	cat, err := glib.SubprocessNew([]string{"cat"}, flags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdin := cat.GetStdinPipe()
+	if _, err := stdin.Write([]byte("through the pipe")); err != nil {
+		t.Fatal(err)
+	}
+	if err := stdin.Close(); err != nil {
+		t.Fatal(err)
+	}
+	out, err := ioutil.ReadAll(cat.GetStdoutPipe())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != "through the pipe" {
+		t.Errorf("Read %q from stdout", out)
+	}
+	if cat.GetStderrPipe() != nil {
+		t.Error("Expected no stderr pipe")
+	}
+	if err := cat.WaitCheck(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSubprocessAsync(t *testing.T) {
+	runtime.LockOSThread()
+
+	dir, err := ioutil.TempDir("", "gotk3-subprocess")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	// The temporary directory may be reached through a symlink.
+	dir, _ = filepath.EvalSymlinks(dir)
+
+	launcher, err := glib.SubprocessLauncherNew(glib.SUBPROCESS_FLAGS_STDIN_PIPE |
+		glib.SUBPROCESS_FLAGS_STDOUT_PIPE | glib.SUBPROCESS_FLAGS_STDERR_PIPE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	launcher.Setenv("GOTK3_TEST", "value", true)
+	launcher.SetCwd(dir)
+	if v, err := launcher.Getenv("GOTK3_TEST"); err != nil || v != "value" {
+		t.Errorf("Getenv returned %q, %v", v, err)
+	}
+	sh, err := launcher.Spawnv([]string{"sh", "-c",
+		`read line; echo "$line $GOTK3_TEST"; pwd; echo error >&2`})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout, stderr string
+	var exitStatus int
+	var checkErr error
+	sh.CommunicateUTF8Async("input\n", func(o, e string, err error) {
+		if err != nil {
+			t.Error(err)
+		}
+		stdout, stderr = o, e
+
+		launcher.SetFlags(glib.SUBPROCESS_FLAGS_NONE)
+		fail, err := launcher.Spawnv([]string{"sh", "-c", "exit 3"})
+		if err != nil {
+			t.Error(err)
+			gtk.MainQuit()
+			return
+		}
+		fail.WaitCheckAsync(func(err error) {
+			checkErr = err
+			if fail.GetIfExited() {
+				exitStatus = fail.GetExitStatus()
+			}
+			gtk.MainQuit()
+		})
+	})
+	gtk.Main()
+
+	if expected := "input value\n" + dir + "\n"; stdout != expected {
+		t.Errorf("stdout is %q, expected %q", stdout, expected)
+	}
+	if stderr != "error\n" {
+		t.Errorf("stderr is %q", stderr)
+	}
+	if checkErr == nil {
+		t.Error("Expected error from WaitCheckAsync")
+	}
+	if exitStatus != 3 {
+		t.Errorf("Exit status is %d, expected 3", exitStatus)
+	}
+}
@@ -0,0 +1,582 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0 gio-2.0
+// #include <gio/gio.h>
+// #include "glib.go.h"
+import "C"
+import (
+	"errors"
+	"fmt"
+	"math"
+	"runtime"
+	"sort"
+	"unsafe"
+)
+
+/*
+ * GVariant
+ */
+
+// Variant is a representation of GLib's GVariant.
+type Variant struct {
+	GVariant *C.GVariant
+}
+
+// native returns a pointer to the underlying GVariant.
+func (v *Variant) native() *C.GVariant {
+	if v == nil {
+		return nil
+	}
+	return v.GVariant
+}
+
+// Native returns a pointer to the underlying GVariant.
+func (v *Variant) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+// takeVariant wraps a GVariant returned either as a floating reference
+// or with full transfer.
+func takeVariant(c *C.GVariant) *Variant {
+	v := &Variant{C.g_variant_take_ref(c)}
+	runtime.SetFinalizer(v, (*Variant).unref)
+	return v
+}
+
+// refVariant wraps a GVariant returned without a transfer of ownership.
+func refVariant(c *C.GVariant) *Variant {
+	v := &Variant{C.g_variant_ref_sink(c)}
+	runtime.SetFinalizer(v, (*Variant).unref)
+	return v
+}
+
+func (v *Variant) unref() {
+	C.g_variant_unref(v.native())
+}
+
+// variantType converts a GVariant type string to a GVariantType.  A
+// non-nil error is returned if typ is not a valid type string.  The
+// returned pointer must be freed with C.free().
+func variantType(typ string) (*C.GVariantType, error) {
+	cstr := C.CString(typ)
+	if !gobool(C.g_variant_type_string_is_valid((*C.gchar)(cstr))) {
+		C.free(unsafe.Pointer(cstr))
+		return nil, fmt.Errorf("invalid variant type '%s'", typ)
+	}
+	return (*C.GVariantType)(unsafe.Pointer(cstr)), nil
+}
+
+// VariantNewBoolean is a wrapper around g_variant_new_boolean().
+func VariantNewBoolean(value bool) *Variant {
+	return takeVariant(C.g_variant_new_boolean(gbool(value)))
+}
+
+// VariantNewByte is a wrapper around g_variant_new_byte().
+func VariantNewByte(value uint8) *Variant {
+	return takeVariant(C.g_variant_new_byte(C.guchar(value)))
+}
+
+// VariantNewInt16 is a wrapper around g_variant_new_int16().
+func VariantNewInt16(value int16) *Variant {
+	return takeVariant(C.g_variant_new_int16(C.gint16(value)))
+}
+
+// VariantNewUint16 is a wrapper around g_variant_new_uint16().
+func VariantNewUint16(value uint16) *Variant {
+	return takeVariant(C.g_variant_new_uint16(C.guint16(value)))
+}
+
+// VariantNewInt32 is a wrapper around g_variant_new_int32().
+func VariantNewInt32(value int32) *Variant {
+	return takeVariant(C.g_variant_new_int32(C.gint32(value)))
+}
+
+// VariantNewUint32 is a wrapper around g_variant_new_uint32().
+func VariantNewUint32(value uint32) *Variant {
+	return takeVariant(C.g_variant_new_uint32(C.guint32(value)))
+}
+
+// VariantNewInt64 is a wrapper around g_variant_new_int64().
+func VariantNewInt64(value int64) *Variant {
+	return takeVariant(C.g_variant_new_int64(C.gint64(value)))
+}
+
+// VariantNewUint64 is a wrapper around g_variant_new_uint64().
+func VariantNewUint64(value uint64) *Variant {
+	return takeVariant(C.g_variant_new_uint64(C.guint64(value)))
+}
+
+// VariantNewDouble is a wrapper around g_variant_new_double().
+func VariantNewDouble(value float64) *Variant {
+	return takeVariant(C.g_variant_new_double(C.gdouble(value)))
+}
+
+// VariantNewString is a wrapper around g_variant_new_string().
+func VariantNewString(value string) *Variant {
+	cstr := C.CString(value)
+	defer C.free(unsafe.Pointer(cstr))
+	return takeVariant(C.g_variant_new_string((*C.gchar)(cstr)))
+}
+
+// VariantNewObjectPath is a wrapper around g_variant_new_object_path().
+// A non-nil error is returned if path is not a valid D-Bus object path.
+func VariantNewObjectPath(path string) (*Variant, error) {
+	cstr := C.CString(path)
+	defer C.free(unsafe.Pointer(cstr))
+	if !gobool(C.g_variant_is_object_path((*C.gchar)(cstr))) {
+		return nil, fmt.Errorf("invalid object path '%s'", path)
+	}
+	return takeVariant(C.g_variant_new_object_path((*C.gchar)(cstr))), nil
+}
+
+// VariantNewSignature is a wrapper around g_variant_new_signature().  A
+// non-nil error is returned if signature is not a valid D-Bus signature.
+func VariantNewSignature(signature string) (*Variant, error) {
+	cstr := C.CString(signature)
+	defer C.free(unsafe.Pointer(cstr))
+	if !gobool(C.g_variant_is_signature((*C.gchar)(cstr))) {
+		return nil, fmt.Errorf("invalid signature '%s'", signature)
+	}
+	return takeVariant(C.g_variant_new_signature((*C.gchar)(cstr))), nil
+}
+
+// VariantNewStrv is a wrapper around g_variant_new_strv().
+func VariantNewStrv(strv []string) *Variant {
+	cstrv := cStrings(strv)
+	defer C.g_strfreev(cstrv)
+	return takeVariant(C.g_variant_new_strv(cstrv, -1))
+}
+
+// VariantNewBytes creates a new Variant of type "ay" holding a copy of
+// data.
+func VariantNewBytes(data []byte) *Variant {
+	t, _ := variantType("y")
+	defer C.free(unsafe.Pointer(t))
+	var p C.gconstpointer
+	if len(data) > 0 {
+		p = C.gconstpointer(unsafe.Pointer(&data[0]))
+	}
+	c := C.g_variant_new_fixed_array(t, p, C.gsize(len(data)), 1)
+	return takeVariant(c)
+}
+
+// VariantNewVariant is a wrapper around g_variant_new_variant().
+func VariantNewVariant(value *Variant) *Variant {
+	return takeVariant(C.g_variant_new_variant(value.native()))
+}
+
+// variantSlice converts a slice of Variants to a slice of their
+// underlying GVariants.
+func variantSlice(children []*Variant) []*C.GVariant {
+	c := make([]*C.GVariant, len(children))
+	for i := range children {
+		c[i] = children[i].native()
+	}
+	return c
+}
+
+// VariantNewTuple is a wrapper around g_variant_new_tuple().
+func VariantNewTuple(children ...*Variant) *Variant {
+	c := variantSlice(children)
+	var p **C.GVariant
+	if len(c) > 0 {
+		p = &c[0]
+	}
+	return takeVariant(C.g_variant_new_tuple(p, C.gsize(len(c))))
+}
+
+// VariantNewArray is a wrapper around g_variant_new_array().  childType
+// is the type string of the array elements, and may only be empty if
+// children is not empty.  All children must be of the same type.
+func VariantNewArray(childType string, children []*Variant) (*Variant, error) {
+	var t *C.GVariantType
+	if childType != "" {
+		var err error
+		if t, err = variantType(childType); err != nil {
+			return nil, err
+		}
+		defer C.free(unsafe.Pointer(t))
+	} else if len(children) == 0 {
+		return nil, errors.New("empty array requires a child type")
+	}
+	for _, child := range children {
+		if childType == "" {
+			childType = child.TypeString()
+		}
+		if child.TypeString() != childType {
+			return nil, errors.New("array children differ in type")
+		}
+	}
+	c := variantSlice(children)
+	var p **C.GVariant
+	if len(c) > 0 {
+		p = &c[0]
+	}
+	return takeVariant(C.g_variant_new_array(t, p, C.gsize(len(c)))), nil
+}
+
+// VariantNewDictEntry is a wrapper around g_variant_new_dict_entry().
+// key must be of a basic type.
+func VariantNewDictEntry(key, value *Variant) (*Variant, error) {
+	if !key.IsOfType("?") {
+		return nil, errors.New("dictionary key must be of a basic type")
+	}
+	return takeVariant(C.g_variant_new_dict_entry(key.native(), value.native())), nil
+}
+
+// VariantParse is a wrapper around g_variant_parse().  typ may be empty
+// if the type can be inferred from text.
+func VariantParse(typ, text string) (*Variant, error) {
+	var t *C.GVariantType
+	if typ != "" {
+		var err error
+		if t, err = variantType(typ); err != nil {
+			return nil, err
+		}
+		defer C.free(unsafe.Pointer(t))
+	}
+	cstr := C.CString(text)
+	defer C.free(unsafe.Pointer(cstr))
+	var err *C.GError
+	c := C.g_variant_parse(t, (*C.gchar)(cstr), nil, nil, &err)
+	if c == nil {
+		defer C.g_error_free(err)
+		return nil, errors.New(C.GoString((*C.char)(err.message)))
+	}
+	return takeVariant(c), nil
+}
+
+// VariantFromGo creates a new Variant from a Go value.  Supported types
+// and the resulting variant types are:
+//
+//	bool                    "b"
+//	uint8                   "y"
+//	int16, uint16           "n", "q"
+//	int32, uint32           "i", "u"
+//	int64, uint64           "x", "t"
+//	int, uint               "i", "u" (an error is returned on overflow)
+//	float64                 "d"
+//	string                  "s"
+//	[]string                "as"
+//	[]byte                  "ay"
+//	[]interface{}           a tuple of the converted elements
+//	map[string]interface{}  "a{sv}"
+//	*Variant                the Variant itself
+func VariantFromGo(v interface{}) (*Variant, error) {
+	switch e := v.(type) {
+	case *Variant:
+		if e == nil {
+			return nil, errNilPtr
+		}
+		return e, nil
+	case bool:
+		return VariantNewBoolean(e), nil
+	case uint8:
+		return VariantNewByte(e), nil
+	case int16:
+		return VariantNewInt16(e), nil
+	case uint16:
+		return VariantNewUint16(e), nil
+	case int32:
+		return VariantNewInt32(e), nil
+	case uint32:
+		return VariantNewUint32(e), nil
+	case int64:
+		return VariantNewInt64(e), nil
+	case uint64:
+		return VariantNewUint64(e), nil
+	case int:
+		if e < math.MinInt32 || e > math.MaxInt32 {
+			return nil, fmt.Errorf("%d overflows int32", e)
+		}
+		return VariantNewInt32(int32(e)), nil
+	case uint:
+		if uint64(e) > math.MaxUint32 {
+			return nil, fmt.Errorf("%d overflows uint32", e)
+		}
+		return VariantNewUint32(uint32(e)), nil
+	case float64:
+		return VariantNewDouble(e), nil
+	case string:
+		return VariantNewString(e), nil
+	case []string:
+		return VariantNewStrv(e), nil
+	case []byte:
+		return VariantNewBytes(e), nil
+	case []interface{}:
+		children := make([]*Variant, len(e))
+		for i := range e {
+			child, err := VariantFromGo(e[i])
+			if err != nil {
+				return nil, err
+			}
+			children[i] = child
+		}
+		return VariantNewTuple(children...), nil
+	case map[string]interface{}:
+		keys := make([]string, 0, len(e))
+		for key := range e {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		entries := make([]*Variant, len(keys))
+		for i, key := range keys {
+			value, err := VariantFromGo(e[key])
+			if err != nil {
+				return nil, err
+			}
+			entries[i], _ = VariantNewDictEntry(VariantNewString(key),
+				VariantNewVariant(value))
+		}
+		return VariantNewArray("{sv}", entries)
+	}
+	return nil, fmt.Errorf("cannot convert %T to a variant", v)
+}
+
+// TypeString is a wrapper around g_variant_get_type_string().
+func (v *Variant) TypeString() string {
+	c := C.g_variant_get_type_string(v.native())
+	return C.GoString((*C.char)(c))
+}
+
+// IsOfType is a wrapper around g_variant_is_of_type().  false is
+// returned if typ is not a valid type string.
+func (v *Variant) IsOfType(typ string) bool {
+	t, err := variantType(typ)
+	if err != nil {
+		return false
+	}
+	defer C.free(unsafe.Pointer(t))
+	return gobool(C.g_variant_is_of_type(v.native(), t))
+}
+
+// IsContainer is a wrapper around g_variant_is_container().
+func (v *Variant) IsContainer() bool {
+	return gobool(C.g_variant_is_container(v.native()))
+}
+
+// Equal is a wrapper around g_variant_equal().
+func (v *Variant) Equal(other *Variant) bool {
+	c := C.g_variant_equal(C.gconstpointer(unsafe.Pointer(v.native())),
+		C.gconstpointer(unsafe.Pointer(other.native())))
+	return gobool(c)
+}
+
+// Print is a wrapper around g_variant_print().
+func (v *Variant) Print(typeAnnotate bool) string {
+	c := C.g_variant_print(v.native(), gbool(typeAnnotate))
+	defer C.g_free(C.gpointer(c))
+	return C.GoString((*C.char)(c))
+}
+
+// String returns the text form of the Variant, as returned by Print.
+func (v *Variant) String() string {
+	return v.Print(false)
+}
+
+// GetBoolean is a wrapper around g_variant_get_boolean().
+func (v *Variant) GetBoolean() bool {
+	return gobool(C.g_variant_get_boolean(v.native()))
+}
+
+// GetByte is a wrapper around g_variant_get_byte().
+func (v *Variant) GetByte() uint8 {
+	return uint8(C.g_variant_get_byte(v.native()))
+}
+
+// GetInt16 is a wrapper around g_variant_get_int16().
+func (v *Variant) GetInt16() int16 {
+	return int16(C.g_variant_get_int16(v.native()))
+}
+
+// GetUint16 is a wrapper around g_variant_get_uint16().
+func (v *Variant) GetUint16() uint16 {
+	return uint16(C.g_variant_get_uint16(v.native()))
+}
+
+// GetInt32 is a wrapper around g_variant_get_int32().
+func (v *Variant) GetInt32() int32 {
+	return int32(C.g_variant_get_int32(v.native()))
+}
+
+// GetUint32 is a wrapper around g_variant_get_uint32().
+func (v *Variant) GetUint32() uint32 {
+	return uint32(C.g_variant_get_uint32(v.native()))
+}
+
+// GetInt64 is a wrapper around g_variant_get_int64().
+func (v *Variant) GetInt64() int64 {
+	return int64(C.g_variant_get_int64(v.native()))
+}
+
+// GetUint64 is a wrapper around g_variant_get_uint64().
+func (v *Variant) GetUint64() uint64 {
+	return uint64(C.g_variant_get_uint64(v.native()))
+}
+
+// GetDouble is a wrapper around g_variant_get_double().
+func (v *Variant) GetDouble() float64 {
+	return float64(C.g_variant_get_double(v.native()))
+}
+
+// GetString is a wrapper around g_variant_get_string().  It may be
+// used with Variants of type "s", "o" and "g".
+func (v *Variant) GetString() string {
+	c := C.g_variant_get_string(v.native(), nil)
+	return C.GoString((*C.char)(c))
+}
+
+// GetStrv is a wrapper around g_variant_dup_strv().
+func (v *Variant) GetStrv() []string {
+	c := C.g_variant_dup_strv(v.native(), nil)
+	defer C.g_strfreev(c)
+	return goStrings(c)
+}
+
+// GetBytes returns a copy of the contents of a Variant of type "ay".
+func (v *Variant) GetBytes() []byte {
+	var n C.gsize
+	c := C.g_variant_get_fixed_array(v.native(), &n, 1)
+	return C.GoBytes(unsafe.Pointer(c), C.int(n))
+}
+
+// GetVariant is a wrapper around g_variant_get_variant().
+func (v *Variant) GetVariant() *Variant {
+	return takeVariant(C.g_variant_get_variant(v.native()))
+}
+
+// GetMaybe is a wrapper around g_variant_get_maybe().  nil is returned
+// for Nothing.
+func (v *Variant) GetMaybe() *Variant {
+	c := C.g_variant_get_maybe(v.native())
+	if c == nil {
+		return nil
+	}
+	return takeVariant(c)
+}
+
+// NChildren is a wrapper around g_variant_n_children().
+func (v *Variant) NChildren() uint {
+	return uint(C.g_variant_n_children(v.native()))
+}
+
+// GetChildValue is a wrapper around g_variant_get_child_value().
+func (v *Variant) GetChildValue(index uint) *Variant {
+	c := C.g_variant_get_child_value(v.native(), C.gsize(index))
+	return takeVariant(c)
+}
+
+// LookupValue is a wrapper around g_variant_lookup_value().  expectedType
+// may be empty to accept values of any type.  nil is returned if key is
+// not found or the value does not have the expected type.
+func (v *Variant) LookupValue(key, expectedType string) *Variant {
+	var t *C.GVariantType
+	if expectedType != "" {
+		var err error
+		if t, err = variantType(expectedType); err != nil {
+			return nil
+		}
+		defer C.free(unsafe.Pointer(t))
+	}
+	cstr := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.g_variant_lookup_value(v.native(), (*C.gchar)(cstr), t)
+	if c == nil {
+		return nil
+	}
+	return takeVariant(c)
+}
+
+// GoValue converts a Variant to a comparable Go type.  This is the
+// reverse of VariantFromGo, with the following additions: handles ("h")
+// are returned as int32, object paths and signatures as string and
+// arrays of them as []string, dictionaries with string keys as
+// map[string]interface{}, other arrays as []interface{}, and maybe types
+// as nil or the converted child.
+func (v *Variant) GoValue() (interface{}, error) {
+	switch C.g_variant_classify(v.native()) {
+	case C.G_VARIANT_CLASS_BOOLEAN:
+		return v.GetBoolean(), nil
+	case C.G_VARIANT_CLASS_BYTE:
+		return v.GetByte(), nil
+	case C.G_VARIANT_CLASS_INT16:
+		return v.GetInt16(), nil
+	case C.G_VARIANT_CLASS_UINT16:
+		return v.GetUint16(), nil
+	case C.G_VARIANT_CLASS_INT32:
+		return v.GetInt32(), nil
+	case C.G_VARIANT_CLASS_UINT32:
+		return v.GetUint32(), nil
+	case C.G_VARIANT_CLASS_INT64:
+		return v.GetInt64(), nil
+	case C.G_VARIANT_CLASS_UINT64:
+		return v.GetUint64(), nil
+	case C.G_VARIANT_CLASS_HANDLE:
+		return int32(C.g_variant_get_handle(v.native())), nil
+	case C.G_VARIANT_CLASS_DOUBLE:
+		return v.GetDouble(), nil
+	case C.G_VARIANT_CLASS_STRING, C.G_VARIANT_CLASS_OBJECT_PATH,
+		C.G_VARIANT_CLASS_SIGNATURE:
+		return v.GetString(), nil
+	case C.G_VARIANT_CLASS_VARIANT:
+		return v.GetVariant().GoValue()
+	case C.G_VARIANT_CLASS_MAYBE:
+		child := v.GetMaybe()
+		if child == nil {
+			return nil, nil
+		}
+		return child.GoValue()
+	case C.G_VARIANT_CLASS_ARRAY:
+		switch typ := v.TypeString(); {
+		case typ == "as":
+			return v.GetStrv(), nil
+		case typ == "ao" || typ == "ag":
+			s := make([]string, v.NChildren())
+			for i := range s {
+				s[i] = v.GetChildValue(uint(i)).GetString()
+			}
+			return s, nil
+		case typ == "ay":
+			return v.GetBytes(), nil
+		case typ[1] == '{' && (typ[2] == 's' || typ[2] == 'o' || typ[2] == 'g'):
+			m := make(map[string]interface{}, v.NChildren())
+			for i := uint(0); i < v.NChildren(); i++ {
+				entry := v.GetChildValue(i)
+				value, err := entry.GetChildValue(1).GoValue()
+				if err != nil {
+					return nil, err
+				}
+				m[entry.GetChildValue(0).GetString()] = value
+			}
+			return m, nil
+		}
+		fallthrough
+	case C.G_VARIANT_CLASS_TUPLE, C.G_VARIANT_CLASS_DICT_ENTRY:
+		s := make([]interface{}, v.NChildren())
+		for i := range s {
+			value, err := v.GetChildValue(uint(i)).GoValue()
+			if err != nil {
+				return nil, err
+			}
+			s[i] = value
+		}
+		return s, nil
+	}
+	return nil, fmt.Errorf("unsupported variant type '%s'", v.TypeString())
+}
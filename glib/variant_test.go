@@ -0,0 +1,59 @@
+package glib_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/conformal/gotk3/glib"
+)
+
+func TestVariantFromGo(t *testing.T) {
+	in := []interface{}{
+		true, uint8(1), int16(-2), uint16(3), int32(-4), uint32(5),
+		int64(-6), uint64(7), 8.5, "nine", []string{"ten"}, []byte("11"),
+		map[string]interface{}{"twelve": int32(12)},
+	}
+	v, err := glib.VariantFromGo(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if typ := v.TypeString(); typ != "(bynqiuxtdsasaya{sv})" {
+		t.Errorf("Variant has type %s", typ)
+	}
+	out, err := v.GoValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(in, out) {
+		t.Errorf("GoValue returned %#v, expected %#v", out, in)
+	}
+
+	if _, err := glib.VariantFromGo(struct{}{}); err == nil {
+		t.Error("Expected error for unsupported type")
+	}
+}
+
+func TestVariantParse(t *testing.T) {
+	v, err := glib.VariantParse("a{si}", "{'a': 1, 'b': 2}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b := v.LookupValue("b", "i"); b == nil || b.GetInt32() != 2 {
+		t.Error("LookupValue did not find b")
+	}
+	if v.LookupValue("c", "") != nil {
+		t.Error("LookupValue found missing key")
+	}
+	if s := v.String(); s != "{'a': 1, 'b': 2}" {
+		t.Errorf("String returned %s", s)
+	}
+
+	other, _ := glib.VariantFromGo(map[string]interface{}{"a": int32(1)})
+	if v.Equal(other) {
+		t.Error("Variants of different types compare equal")
+	}
+
+	if _, err := glib.VariantParse("i", "'string'"); err == nil {
+		t.Error("Expected error for mismatched type")
+	}
+}
@@ -195,6 +195,27 @@ func gobool(b C.gboolean) bool {
 	return false
 }
 
+// toGIcon returns the underlying GIcon of a glib.IIcon, or nil if icon
+// is nil or holds a nil pointer.
+func toGIcon(icon glib.IIcon) *C.GIcon {
+	if icon == nil || icon.Native() == 0 {
+		return nil
+	}
+	return (*C.GIcon)(unsafe.Pointer(icon.Native()))
+}
+
+// newIcon wraps a GIcon returned without a transfer of ownership, or
+// returns nil if c is NULL.
+func newIcon(c *C.GIcon) *glib.Icon {
+	if c == nil {
+		return nil
+	}
+	obj := &glib.Object{glib.ToGObject(unsafe.Pointer(c))}
+	obj.Ref()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return &glib.Icon{obj}
+}
+
 // Wrapper function for TestBoolConvs since cgo can't be used with
 // testing package
 func testBoolConvs() error {
@@ -772,6 +793,58 @@ func MainQuit() {
 	C.gtk_main_quit()
 }
 
+/*
+ * Keyboard accelerators
+ */
+
+// AcceleratorParse is a wrapper around gtk_accelerator_parse().  It
+// parses an accelerator string such as "<Control>a" or "<Shift><Alt>F1".
+// If the string cannot be parsed, key and mods are both 0.
+func AcceleratorParse(accelerator string) (key uint, mods gdk.ModifierType) {
+	cstr := C.CString(accelerator)
+	defer C.free(unsafe.Pointer(cstr))
+	var ckey C.guint
+	var cmods C.GdkModifierType
+	C.gtk_accelerator_parse((*C.gchar)(cstr), &ckey, &cmods)
+	return uint(ckey), gdk.ModifierType(cmods)
+}
+
+// AcceleratorName is a wrapper around gtk_accelerator_name().  It
+// returns an accelerator string which may be parsed by AcceleratorParse.
+func AcceleratorName(key uint, mods gdk.ModifierType) string {
+	c := C.gtk_accelerator_name(C.guint(key), C.GdkModifierType(mods))
+	defer C.g_free(C.gpointer(c))
+	return C.GoString((*C.char)(c))
+}
+
+// AcceleratorGetLabel is a wrapper around gtk_accelerator_get_label().
+// It returns a localized string for displaying the accelerator to the
+// user.
+func AcceleratorGetLabel(key uint, mods gdk.ModifierType) string {
+	c := C.gtk_accelerator_get_label(C.guint(key), C.GdkModifierType(mods))
+	defer C.g_free(C.gpointer(c))
+	return C.GoString((*C.char)(c))
+}
+
+// AcceleratorValid is a wrapper around gtk_accelerator_valid().
+func AcceleratorValid(key uint, mods gdk.ModifierType) bool {
+	c := C.gtk_accelerator_valid(C.guint(key), C.GdkModifierType(mods))
+	return gobool(c)
+}
+
+// AcceleratorGetDefaultModMask is a wrapper around
+// gtk_accelerator_get_default_mod_mask().
+func AcceleratorGetDefaultModMask() gdk.ModifierType {
+	c := C.gtk_accelerator_get_default_mod_mask()
+	return gdk.ModifierType(c)
+}
+
+// AcceleratorSetDefaultModMask is a wrapper around
+// gtk_accelerator_set_default_mod_mask().
+func AcceleratorSetDefaultModMask(mods gdk.ModifierType) {
+	C.gtk_accelerator_set_default_mod_mask(C.GdkModifierType(mods))
+}
+
 /*
  * GtkAboutDialog
  */
@@ -3057,11 +3130,16 @@ func (v *Entry) GetOverwriteMode() bool {
 	return gobool(c)
 }
 
-// TODO(jrick) Pangolayout
-/*
-func (v *Entry) GetLayout() {
+// GetLayout() is a wrapper around gtk_entry_get_layout().  The layout is
+// owned by the entry and is replaced when the entry's text changes.
+func (v *Entry) GetLayout() *pango.Layout {
+	c := C.gtk_entry_get_layout(v.native())
+	obj := &glib.Object{glib.ToGObject(unsafe.Pointer(c))}
+	l := &pango.Layout{obj}
+	obj.Ref()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return l
 }
-*/
 
 // GetLayoutOffsets() is a wrapper around gtk_entry_get_layout_offsets().
 func (v *Entry) GetLayoutOffsets() (x, y int) {
@@ -3086,17 +3164,18 @@ func (v *Entry) TextIndexToLayoutIndex(textIndex int) int {
 	return int(c)
 }
 
-// TODO(jrick) PandoAttrList
-/*
-func (v *Entry) SetAttributes() {
+// SetAttributes() is a wrapper around gtk_entry_set_attributes().
+func (v *Entry) SetAttributes(attrs *pango.AttrList) {
+	l := (*C.PangoAttrList)(unsafe.Pointer(attrs.Native()))
+	C.gtk_entry_set_attributes(v.native(), l)
 }
-*/
 
-// TODO(jrick) PandoAttrList
-/*
-func (v *Entry) GetAttributes() {
+// GetAttributes() is a wrapper around gtk_entry_get_attributes().  It
+// returns nil if no attributes have been set.
+func (v *Entry) GetAttributes() *pango.AttrList {
+	c := C.gtk_entry_get_attributes(v.native())
+	return pango.NewAttrList(uintptr(unsafe.Pointer(c)), true)
 }
-*/
 
 // GetMaxLength() is a wrapper around gtk_entry_get_max_length().
 func (v *Entry) GetMaxLength() int {
@@ -3177,11 +3256,13 @@ func (v *Entry) ProgressPulse() {
 	C.gtk_entry_progress_pulse(v.native())
 }
 
-// TODO(jrick) GdkEventKey
-/*
-func (v *Entry) IMContextFilterKeypress() {
+// IMContextFilterKeypress() is a wrapper around
+// gtk_entry_im_context_filter_keypress().
+func (v *Entry) IMContextFilterKeypress(event *gdk.EventKey) bool {
+	e := (*C.GdkEventKey)(unsafe.Pointer(event.Native()))
+	c := C.gtk_entry_im_context_filter_keypress(v.native(), e)
+	return gobool(c)
 }
-*/
 
 // ResetIMContext() is a wrapper around gtk_entry_reset_im_context().
 func (v *Entry) ResetIMContext() {
@@ -3203,11 +3284,11 @@ func (v *Entry) SetIconFromIconName(iconPos EntryIconPosition, name string) {
 		C.GtkEntryIconPosition(iconPos), (*C.gchar)(cstr))
 }
 
-// TODO(jrick) GIcon
-/*
-func (v *Entry) SetIconFromGIcon() {
+// SetIconFromGIcon() is a wrapper around gtk_entry_set_icon_from_gicon().
+func (v *Entry) SetIconFromGIcon(iconPos EntryIconPosition, icon glib.IIcon) {
+	C.gtk_entry_set_icon_from_gicon(v.native(),
+		C.GtkEntryIconPosition(iconPos), toGIcon(icon))
 }
-*/
 
 // GetIconStorageType() is a wrapper around gtk_entry_get_icon_storage_type().
 func (v *Entry) GetIconStorageType(iconPos EntryIconPosition) ImageType {
@@ -3232,11 +3313,13 @@ func (v *Entry) GetIconName(iconPos EntryIconPosition) (string, error) {
 	return C.GoString((*C.char)(c)), nil
 }
 
-// TODO(jrick) GIcon
-/*
-func (v *Entry) GetIconGIcon() {
+// GetIconGIcon() is a wrapper around gtk_entry_get_icon_gicon().  nil is
+// returned if the icon was not set from a GIcon.
+func (v *Entry) GetIconGIcon(iconPos EntryIconPosition) *glib.Icon {
+	c := C.gtk_entry_get_icon_gicon(v.native(),
+		C.GtkEntryIconPosition(iconPos))
+	return newIcon(c)
 }
-*/
 
 // SetIconActivatable() is a wrapper around gtk_entry_set_icon_activatable().
 func (v *Entry) SetIconActivatable(iconPos EntryIconPosition, activatable bool) {
@@ -4033,11 +4116,18 @@ func ImageNewFromIconName(iconName string, size IconSize) (*Image, error) {
 	return i, nil
 }
 
-// TODO(jrick) GIcon
-/*
-func ImageNewFromGIcon() {
+// ImageNewFromGIcon() is a wrapper around gtk_image_new_from_gicon().
+func ImageNewFromGIcon(icon glib.IIcon, size IconSize) (*Image, error) {
+	c := C.gtk_image_new_from_gicon(toGIcon(icon), C.GtkIconSize(size))
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	obj := &glib.Object{glib.ToGObject(unsafe.Pointer(c))}
+	i := wrapImage(obj)
+	obj.RefSink()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return i, nil
 }
-*/
 
 // Clear() is a wrapper around gtk_image_clear().
 func (v *Image) Clear() {
@@ -4084,11 +4174,10 @@ func (v *Image) SetFromIconName(iconName string, size IconSize) {
 		C.GtkIconSize(size))
 }
 
-// TODO(jrick) GIcon
-/*
-func (v *Image) SetFromGIcon() {
+// SetFromGIcon() is a wrapper around gtk_image_set_from_gicon().
+func (v *Image) SetFromGIcon(icon glib.IIcon, size IconSize) {
+	C.gtk_image_set_from_gicon(v.native(), toGIcon(icon), C.GtkIconSize(size))
 }
-*/
 
 // SetPixelSize() is a wrapper around gtk_image_set_pixel_size().
 func (v *Image) SetPixelSize(pixelSize int) {
@@ -4134,11 +4223,14 @@ func (v *Image) GetIconName() (string, IconSize) {
 	return C.GoString((*C.char)(iconName)), IconSize(size)
 }
 
-// TODO(jrick) GIcon
-/*
-func (v *Image) GetGIcon() {
+// GetGIcon() is a wrapper around gtk_image_get_gicon().  A nil icon is
+// returned if the image was not set from a GIcon.
+func (v *Image) GetGIcon() (*glib.Icon, IconSize) {
+	var icon *C.GIcon
+	var size C.GtkIconSize
+	C.gtk_image_get_gicon(v.native(), &icon, &size)
+	return newIcon(icon), IconSize(size)
 }
-*/
 
 // GetPixelSize() is a wrapper around gtk_image_get_pixel_size().
 func (v *Image) GetPixelSize() int {
@@ -4376,6 +4468,39 @@ func LabelNewWithMnemonic(str string) (*Label, error) {
 	return l, nil
 }
 
+// SetAttributes is a wrapper around gtk_label_set_attributes().  The
+// attributes are applied in addition to any set by markup.
+func (v *Label) SetAttributes(attrs *pango.AttrList) {
+	l := (*C.PangoAttrList)(unsafe.Pointer(attrs.Native()))
+	C.gtk_label_set_attributes(v.native(), l)
+}
+
+// GetAttributes is a wrapper around gtk_label_get_attributes().  It
+// returns nil if no attributes have been set, and does not include
+// attributes set by markup.
+func (v *Label) GetAttributes() *pango.AttrList {
+	c := C.gtk_label_get_attributes(v.native())
+	return pango.NewAttrList(uintptr(unsafe.Pointer(c)), true)
+}
+
+// GetLayout is a wrapper around gtk_label_get_layout().  The layout is
+// owned by the label and is replaced when the label's text changes.
+func (v *Label) GetLayout() *pango.Layout {
+	c := C.gtk_label_get_layout(v.native())
+	obj := &glib.Object{glib.ToGObject(unsafe.Pointer(c))}
+	l := &pango.Layout{obj}
+	obj.Ref()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return l
+}
+
+// GetLayoutOffsets is a wrapper around gtk_label_get_layout_offsets().
+func (v *Label) GetLayoutOffsets() (x, y int) {
+	var gx, gy C.gint
+	C.gtk_label_get_layout_offsets(v.native(), &gx, &gy)
+	return int(gx), int(gy)
+}
+
 // SelectRegion is a wrapper around gtk_label_select_region().
 func (v *Label) SelectRegion(startOffset, endOffset int) {
 	C.gtk_label_select_region(v.native(), C.gint(startOffset),
@@ -6338,6 +6463,19 @@ func StatusIconNewFromIconName(iconName string) (*StatusIcon, error) {
 	return e, nil
 }
 
+// StatusIconNewFromGIcon is a wrapper around gtk_status_icon_new_from_gicon()
+func StatusIconNewFromGIcon(icon glib.IIcon) (*StatusIcon, error) {
+	s := C.gtk_status_icon_new_from_gicon(toGIcon(icon))
+	if s == nil {
+		return nil, nilPtrErr
+	}
+	obj := &glib.Object{glib.ToGObject(unsafe.Pointer(s))}
+	obj.RefSink()
+	e := wrapStatusIcon(obj)
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return e, nil
+}
+
 // SetFromFile is a wrapper around gtk_status_icon_set_from_file()
 func (v *StatusIcon) SetFromFile(filename string) {
 	cstr := C.CString(filename)
@@ -6352,6 +6490,17 @@ func (v *StatusIcon) SetFromIconName(iconName string) {
 	C.gtk_status_icon_set_from_icon_name(v.native(), (*C.gchar)(cstr))
 }
 
+// SetFromGIcon is a wrapper around gtk_status_icon_set_from_gicon()
+func (v *StatusIcon) SetFromGIcon(icon glib.IIcon) {
+	C.gtk_status_icon_set_from_gicon(v.native(), toGIcon(icon))
+}
+
+// GetGIcon is a wrapper around gtk_status_icon_get_gicon().  nil is
+// returned if the icon was not set from a GIcon.
+func (v *StatusIcon) GetGIcon() *glib.Icon {
+	return newIcon(C.gtk_status_icon_get_gicon(v.native()))
+}
+
 // GetStorageType is a wrapper around gtk_status_icon_get_storage_type()
 func (v *StatusIcon) GetStorageType() ImageType {
 	return (ImageType)(C.gtk_status_icon_get_storage_type(v.native()))
@@ -6774,6 +6923,19 @@ func (v *TextView) GetIndent() int {
 	return int(c)
 }
 
+// SetTabs is a wrapper around gtk_text_view_set_tabs().
+func (v *TextView) SetTabs(tabs *pango.TabArray) {
+	t := (*C.PangoTabArray)(unsafe.Pointer(tabs.Native()))
+	C.gtk_text_view_set_tabs(v.native(), t)
+}
+
+// GetTabs is a wrapper around gtk_text_view_get_tabs().  It returns nil
+// if the default tab stops are used.
+func (v *TextView) GetTabs() *pango.TabArray {
+	c := C.gtk_text_view_get_tabs(v.native())
+	return pango.NewTabArray(uintptr(unsafe.Pointer(c)), false)
+}
+
 // SetInputHints is a wrapper around gtk_text_view_set_input_hints().
 func (v *TextView) SetInputHints(hints InputHints) {
 	C.gtk_text_view_set_input_hints(v.native(), C.GtkInputHints(hints))
@@ -8378,6 +8540,41 @@ func (v *Widget) OverrideFont(description string) {
 	C.gtk_widget_override_font(v.native(), c)
 }
 
+// GetPangoContext is a wrapper around gtk_widget_get_pango_context().
+// The context is owned by the widget and is updated when the widget's
+// font or screen change.
+func (v *Widget) GetPangoContext() *pango.Context {
+	c := C.gtk_widget_get_pango_context(v.native())
+	obj := &glib.Object{glib.ToGObject(unsafe.Pointer(c))}
+	ctx := &pango.Context{obj}
+	obj.Ref()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return ctx
+}
+
+// CreatePangoContext is a wrapper around
+// gtk_widget_create_pango_context().
+func (v *Widget) CreatePangoContext() *pango.Context {
+	c := C.gtk_widget_create_pango_context(v.native())
+	obj := &glib.Object{glib.ToGObject(unsafe.Pointer(c))}
+	ctx := &pango.Context{obj}
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return ctx
+}
+
+// CreatePangoLayout is a wrapper around gtk_widget_create_pango_layout().
+// The layout uses the widget's font and is not updated when the widget
+// changes; call ContextChanged on the layout after a style change.
+func (v *Widget) CreatePangoLayout(text string) *pango.Layout {
+	cstr := C.CString(text)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.gtk_widget_create_pango_layout(v.native(), (*C.gchar)(cstr))
+	obj := &glib.Object{glib.ToGObject(unsafe.Pointer(c))}
+	l := &pango.Layout{obj}
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return l
+}
+
 // GetHAlign is a wrapper around gtk_widget_get_halign().
 func (v *Widget) GetHAlign() Align {
 	c := C.gtk_widget_get_halign(v.native())
@@ -8466,6 +8663,28 @@ func (v *Widget) QueueDraw() {
 	C.gtk_widget_queue_draw(v.native())
 }
 
+// QueueDrawRegion is a wrapper around gtk_widget_queue_draw_region().
+func (v *Widget) QueueDrawRegion(region *cairo.Region) {
+	r := (*C.cairo_region_t)(unsafe.Pointer(region.Native()))
+	C.gtk_widget_queue_draw_region(v.native(), r)
+}
+
+// ShapeCombineRegion is a wrapper around
+// gtk_widget_shape_combine_region().  Passing a nil region removes the
+// shape.
+func (v *Widget) ShapeCombineRegion(region *cairo.Region) {
+	r := (*C.cairo_region_t)(unsafe.Pointer(region.Native()))
+	C.gtk_widget_shape_combine_region(v.native(), r)
+}
+
+// InputShapeCombineRegion is a wrapper around
+// gtk_widget_input_shape_combine_region().  Passing a nil region removes
+// the input shape.
+func (v *Widget) InputShapeCombineRegion(region *cairo.Region) {
+	r := (*C.cairo_region_t)(unsafe.Pointer(region.Native()))
+	C.gtk_widget_input_shape_combine_region(v.native(), r)
+}
+
 /*
  * GtkWindow
  */
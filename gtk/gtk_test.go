@@ -20,6 +20,7 @@ package gtk
 
 import (
 	"fmt"
+	"github.com/conformal/gotk3/gdk"
 	"github.com/conformal/gotk3/glib"
 	"log"
 	"testing"
@@ -37,6 +38,27 @@ func TestBoolConvs(t *testing.T) {
 	}
 }
 
+// TestAccelerator tests parsing and naming keyboard accelerators.
+func TestAccelerator(t *testing.T) {
+	key, mods := AcceleratorParse("<Control><Shift>a")
+	if key != gdk.KEY_a || mods != gdk.CONTROL_MASK|gdk.SHIFT_MASK {
+		t.Errorf("AcceleratorParse returned %#x, %#x", key, mods)
+	}
+	if !AcceleratorValid(key, mods) {
+		t.Error("Parsed accelerator is not valid")
+	}
+	name := AcceleratorName(key, mods)
+	if k, m := AcceleratorParse(name); k != key || m != mods {
+		t.Errorf("AcceleratorName returned unparsable %q", name)
+	}
+	if AcceleratorGetLabel(key, mods) == "" {
+		t.Error("AcceleratorGetLabel returned empty label")
+	}
+	if key, _ := AcceleratorParse("<Control>"); key != 0 {
+		t.Errorf("Parsed invalid accelerator as %#x", key)
+	}
+}
+
 // TestBox tests creating and adding widgets to a Box
 func TestBox(t *testing.T) {
 	vbox, err := BoxNew(ORIENTATION_VERTICAL, 0)
@@ -78,6 +100,32 @@ func TestBox(t *testing.T) {
 	vbox.PackStart(start, true, true, 3)
 	vbox.PackEnd(end, true, true, 3)
 }
+
+// TestImageGIcon tests setting and getting the GIcon of an Image.
+func TestImageGIcon(t *testing.T) {
+	icon := glib.ThemedIconNew("folder")
+	image, err := ImageNewFromGIcon(icon, ICON_SIZE_BUTTON)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, size := image.GetGIcon()
+	if got == nil || !got.Equal(icon) || size != ICON_SIZE_BUTTON {
+		t.Error("GetGIcon did not return the icon set")
+	}
+
+	image.Clear()
+	if got, _ := image.GetGIcon(); got != nil {
+		t.Error("Expected nil icon after Clear")
+	}
+
+	var nilIcon *glib.ThemedIcon
+	image.SetFromGIcon(icon, ICON_SIZE_BUTTON)
+	image.SetFromGIcon(nilIcon, ICON_SIZE_BUTTON)
+	if got, _ := image.GetGIcon(); got != nil {
+		t.Error("Expected nil icon after setting nil icon")
+	}
+}
+
 func TestTextBuffer_WhenSetText_ExpectGetTextReturnsSame(t *testing.T) {
 	buffer, err := TextBufferNew(nil)
 	if err != nil {
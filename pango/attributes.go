@@ -0,0 +1,532 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package pango
+
+// #cgo pkg-config: pango
+// #include <stdlib.h>
+// #include <pango/pango.h>
+// #include "pango.go.h"
+import "C"
+import (
+	"errors"
+	"runtime"
+	"unsafe"
+)
+
+// ATTR_INDEX_TO_TEXT_END is a representation of Pango's
+// PANGO_ATTR_INDEX_TO_TEXT_END, an end index extending an attribute to
+// the end of the text.
+const ATTR_INDEX_TO_TEXT_END uint = C.G_MAXUINT
+
+/*
+ * PangoColor
+ */
+
+// Color is a representation of Pango's PangoColor.  Each component
+// ranges from 0 to 65535.
+type Color struct {
+	Red, Green, Blue uint16
+}
+
+// ColorParse is a wrapper around pango_color_parse().  The spec may be a
+// color name, such as "red", or a hexadecimal value in the form "#rgb",
+// "#rrggbb", "#rrrgggbbb" or "#rrrrggggbbbb".
+func ColorParse(spec string) (Color, error) {
+	cstr := C.CString(spec)
+	defer C.free(unsafe.Pointer(cstr))
+	var c C.PangoColor
+	if !gobool(C.pango_color_parse(&c, cstr)) {
+		return Color{}, errors.New("invalid color specification: " + spec)
+	}
+	return Color{uint16(c.red), uint16(c.green), uint16(c.blue)}, nil
+}
+
+/*
+ * PangoAttribute
+ */
+
+// Attribute is a representation of Pango's PangoAttribute.  Attributes
+// apply to the bytes of text from their start index up to, but not
+// including, their end index.  Newly created attributes cover all text;
+// use SetStartIndex and SetEndIndex to restrict them before inserting
+// them in an AttrList.
+type Attribute struct {
+	attribute *C.PangoAttribute
+}
+
+// native returns a pointer to the underlying PangoAttribute.
+func (v *Attribute) native() *C.PangoAttribute {
+	if v == nil {
+		return nil
+	}
+	return v.attribute
+}
+
+// Native returns a pointer to the underlying PangoAttribute.
+func (v *Attribute) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+// takeAttribute wraps a newly-created PangoAttribute.
+func takeAttribute(attr *C.PangoAttribute) *Attribute {
+	a := &Attribute{attr}
+	runtime.SetFinalizer(a, (*Attribute).destroy)
+	return a
+}
+
+// destroy is a wrapper around pango_attribute_destroy().
+func (v *Attribute) destroy() {
+	C.pango_attribute_destroy(v.native())
+}
+
+// Copy is a wrapper around pango_attribute_copy().
+func (v *Attribute) Copy() *Attribute {
+	c := C.pango_attribute_copy(v.native())
+	return takeAttribute(c)
+}
+
+// Equal is a wrapper around pango_attribute_equal().  Only the types and
+// values of the attributes are compared, not their ranges.
+func (v *Attribute) Equal(other *Attribute) bool {
+	c := C.pango_attribute_equal(v.native(), other.native())
+	return gobool(c)
+}
+
+// GetType returns the type of the attribute.
+func (v *Attribute) GetType() AttrType {
+	return AttrType(v.native().klass._type)
+}
+
+// GetStartIndex returns the byte index at which the attribute starts.
+func (v *Attribute) GetStartIndex() uint {
+	return uint(v.native().start_index)
+}
+
+// SetStartIndex sets the byte index at which the attribute starts.
+func (v *Attribute) SetStartIndex(index uint) {
+	v.native().start_index = C.guint(index)
+}
+
+// GetEndIndex returns the byte index at which the attribute ends.
+func (v *Attribute) GetEndIndex() uint {
+	return uint(v.native().end_index)
+}
+
+// SetEndIndex sets the byte index at which the attribute ends.  Use
+// ATTR_INDEX_TO_TEXT_END to apply the attribute to the end of the text.
+func (v *Attribute) SetEndIndex(index uint) {
+	v.native().end_index = C.guint(index)
+}
+
+// GetInt returns the value of an attribute holding an integer, such as
+// weight, style, underline, strikethrough or letter spacing attributes.
+// Enum values are returned as their integer values.  The ok result is
+// false for attributes of other types.
+func (v *Attribute) GetInt() (value int, ok bool) {
+	switch v.GetType() {
+	case ATTR_SIZE, ATTR_ABSOLUTE_SIZE:
+		c := (*C.PangoAttrSize)(unsafe.Pointer(v.native()))
+		return int(c.size), true
+	case ATTR_STYLE, ATTR_WEIGHT, ATTR_VARIANT, ATTR_STRETCH,
+		ATTR_UNDERLINE, ATTR_STRIKETHROUGH, ATTR_RISE, ATTR_FALLBACK,
+		ATTR_LETTER_SPACING, ATTR_GRAVITY, ATTR_GRAVITY_HINT:
+		c := (*C.PangoAttrInt)(unsafe.Pointer(v.native()))
+		return int(c.value), true
+	}
+	return 0, false
+}
+
+// GetFloat returns the value of a scale attribute.  The ok result is
+// false for attributes of other types.
+func (v *Attribute) GetFloat() (value float64, ok bool) {
+	if v.GetType() != ATTR_SCALE {
+		return 0, false
+	}
+	c := (*C.PangoAttrFloat)(unsafe.Pointer(v.native()))
+	return float64(c.value), true
+}
+
+// GetString returns the value of a family attribute.  The ok result is
+// false for attributes of other types.
+func (v *Attribute) GetString() (value string, ok bool) {
+	if v.GetType() != ATTR_FAMILY {
+		return "", false
+	}
+	c := (*C.PangoAttrString)(unsafe.Pointer(v.native()))
+	return C.GoString(c.value), true
+}
+
+// GetColor returns the value of a foreground, background, underline
+// color or strikethrough color attribute.  The ok result is false for
+// attributes of other types.
+func (v *Attribute) GetColor() (value Color, ok bool) {
+	switch v.GetType() {
+	case ATTR_FOREGROUND, ATTR_BACKGROUND, ATTR_UNDERLINE_COLOR,
+		ATTR_STRIKETHROUGH_COLOR:
+		c := (*C.PangoAttrColor)(unsafe.Pointer(v.native()))
+		return Color{uint16(c.color.red), uint16(c.color.green),
+			uint16(c.color.blue)}, true
+	}
+	return Color{}, false
+}
+
+// GetFontDescription returns a copy of the value of a font description
+// attribute.  It returns nil for attributes of other types.
+func (v *Attribute) GetFontDescription() *FontDescription {
+	if v.GetType() != ATTR_FONT_DESC {
+		return nil
+	}
+	c := (*C.PangoAttrFontDesc)(unsafe.Pointer(v.native()))
+	return takeFontDescription(C.pango_font_description_copy(c.desc))
+}
+
+// AttrForegroundNew is a wrapper around pango_attr_foreground_new().
+func AttrForegroundNew(red, green, blue uint16) *Attribute {
+	c := C.pango_attr_foreground_new(C.guint16(red), C.guint16(green),
+		C.guint16(blue))
+	return takeAttribute(c)
+}
+
+// AttrBackgroundNew is a wrapper around pango_attr_background_new().
+func AttrBackgroundNew(red, green, blue uint16) *Attribute {
+	c := C.pango_attr_background_new(C.guint16(red), C.guint16(green),
+		C.guint16(blue))
+	return takeAttribute(c)
+}
+
+// AttrFamilyNew is a wrapper around pango_attr_family_new().
+func AttrFamilyNew(family string) *Attribute {
+	cstr := C.CString(family)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.pango_attr_family_new(cstr)
+	return takeAttribute(c)
+}
+
+// AttrWeightNew is a wrapper around pango_attr_weight_new().
+func AttrWeightNew(weight Weight) *Attribute {
+	c := C.pango_attr_weight_new(C.PangoWeight(weight))
+	return takeAttribute(c)
+}
+
+// AttrStyleNew is a wrapper around pango_attr_style_new().
+func AttrStyleNew(style Style) *Attribute {
+	c := C.pango_attr_style_new(C.PangoStyle(style))
+	return takeAttribute(c)
+}
+
+// AttrVariantNew is a wrapper around pango_attr_variant_new().
+func AttrVariantNew(variant Variant) *Attribute {
+	c := C.pango_attr_variant_new(C.PangoVariant(variant))
+	return takeAttribute(c)
+}
+
+// AttrStretchNew is a wrapper around pango_attr_stretch_new().
+func AttrStretchNew(stretch Stretch) *Attribute {
+	c := C.pango_attr_stretch_new(C.PangoStretch(stretch))
+	return takeAttribute(c)
+}
+
+// AttrSizeNew is a wrapper around pango_attr_size_new().  The size is
+// given in points scaled by SCALE.
+func AttrSizeNew(size int) *Attribute {
+	c := C.pango_attr_size_new(C.int(size))
+	return takeAttribute(c)
+}
+
+// AttrSizeNewAbsolute is a wrapper around
+// pango_attr_size_new_absolute().  The size is given in device units
+// scaled by SCALE.
+func AttrSizeNewAbsolute(size int) *Attribute {
+	c := C.pango_attr_size_new_absolute(C.int(size))
+	return takeAttribute(c)
+}
+
+// AttrFontDescNew is a wrapper around pango_attr_font_desc_new().  The
+// attribute holds a copy of desc.
+func AttrFontDescNew(desc *FontDescription) *Attribute {
+	c := C.pango_attr_font_desc_new(desc.native())
+	return takeAttribute(c)
+}
+
+// AttrUnderlineNew is a wrapper around pango_attr_underline_new().
+func AttrUnderlineNew(underline Underline) *Attribute {
+	c := C.pango_attr_underline_new(C.PangoUnderline(underline))
+	return takeAttribute(c)
+}
+
+// AttrUnderlineColorNew is a wrapper around
+// pango_attr_underline_color_new().
+func AttrUnderlineColorNew(red, green, blue uint16) *Attribute {
+	c := C.pango_attr_underline_color_new(C.guint16(red),
+		C.guint16(green), C.guint16(blue))
+	return takeAttribute(c)
+}
+
+// AttrStrikethroughNew is a wrapper around
+// pango_attr_strikethrough_new().
+func AttrStrikethroughNew(strikethrough bool) *Attribute {
+	c := C.pango_attr_strikethrough_new(gbool(strikethrough))
+	return takeAttribute(c)
+}
+
+// AttrStrikethroughColorNew is a wrapper around
+// pango_attr_strikethrough_color_new().
+func AttrStrikethroughColorNew(red, green, blue uint16) *Attribute {
+	c := C.pango_attr_strikethrough_color_new(C.guint16(red),
+		C.guint16(green), C.guint16(blue))
+	return takeAttribute(c)
+}
+
+// AttrRiseNew is a wrapper around pango_attr_rise_new().  The rise is
+// the displacement of the text from the baseline in Pango units, with
+// positive values moving the text upwards.
+func AttrRiseNew(rise int) *Attribute {
+	c := C.pango_attr_rise_new(C.int(rise))
+	return takeAttribute(c)
+}
+
+// AttrLetterSpacingNew is a wrapper around
+// pango_attr_letter_spacing_new().  The spacing between characters is
+// given in Pango units.
+func AttrLetterSpacingNew(letterSpacing int) *Attribute {
+	c := C.pango_attr_letter_spacing_new(C.int(letterSpacing))
+	return takeAttribute(c)
+}
+
+// AttrScaleNew is a wrapper around pango_attr_scale_new().  The scale
+// factor multiplies the font size.
+func AttrScaleNew(scaleFactor float64) *Attribute {
+	c := C.pango_attr_scale_new(C.double(scaleFactor))
+	return takeAttribute(c)
+}
+
+// AttrFallbackNew is a wrapper around pango_attr_fallback_new().
+func AttrFallbackNew(enableFallback bool) *Attribute {
+	c := C.pango_attr_fallback_new(gbool(enableFallback))
+	return takeAttribute(c)
+}
+
+// AttrGravityNew is a wrapper around pango_attr_gravity_new().
+func AttrGravityNew(gravity Gravity) *Attribute {
+	c := C.pango_attr_gravity_new(C.PangoGravity(gravity))
+	return takeAttribute(c)
+}
+
+// AttrGravityHintNew is a wrapper around pango_attr_gravity_hint_new().
+func AttrGravityHintNew(hint GravityHint) *Attribute {
+	c := C.pango_attr_gravity_hint_new(C.PangoGravityHint(hint))
+	return takeAttribute(c)
+}
+
+/*
+ * PangoAttrList
+ */
+
+// AttrList is a representation of Pango's PangoAttrList, a list of
+// attributes applying to a section of text.
+type AttrList struct {
+	attrList *C.PangoAttrList
+}
+
+// native returns a pointer to the underlying PangoAttrList.
+func (v *AttrList) native() *C.PangoAttrList {
+	if v == nil {
+		return nil
+	}
+	return v.attrList
+}
+
+// Native returns a pointer to the underlying PangoAttrList.
+func (v *AttrList) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalAttrList(p uintptr) (interface{}, error) {
+	c := C.g_value_get_boxed((*C.GValue)(unsafe.Pointer(p)))
+	return NewAttrList(uintptr(c), true), nil
+}
+
+// NewAttrList creates a new AttrList from a pointer to a C
+// PangoAttrList, for use by other packages.  If needsRef is true, a
+// reference is added for the lifetime of the returned value.  It returns
+// nil if p is 0.
+func NewAttrList(p uintptr, needsRef bool) *AttrList {
+	if p == 0 {
+		return nil
+	}
+	list := &AttrList{(*C.PangoAttrList)(unsafe.Pointer(p))}
+	if needsRef {
+		C.pango_attr_list_ref(list.native())
+	}
+	runtime.SetFinalizer(list, (*AttrList).unref)
+	return list
+}
+
+// AttrListNew is a wrapper around pango_attr_list_new().
+func AttrListNew() *AttrList {
+	c := C.pango_attr_list_new()
+	return NewAttrList(uintptr(unsafe.Pointer(c)), false)
+}
+
+// unref is a wrapper around pango_attr_list_unref().
+func (v *AttrList) unref() {
+	C.pango_attr_list_unref(v.native())
+}
+
+// Copy is a wrapper around pango_attr_list_copy().
+func (v *AttrList) Copy() *AttrList {
+	c := C.pango_attr_list_copy(v.native())
+	return NewAttrList(uintptr(unsafe.Pointer(c)), false)
+}
+
+// Insert is a wrapper around pango_attr_list_insert().  A copy of attr
+// is inserted after any other attributes with the same start index.
+func (v *AttrList) Insert(attr *Attribute) {
+	C.pango_attr_list_insert(v.native(),
+		C.pango_attribute_copy(attr.native()))
+}
+
+// InsertBefore is a wrapper around pango_attr_list_insert_before().  A
+// copy of attr is inserted before any other attributes with the same
+// start index.
+func (v *AttrList) InsertBefore(attr *Attribute) {
+	C.pango_attr_list_insert_before(v.native(),
+		C.pango_attribute_copy(attr.native()))
+}
+
+// Change is a wrapper around pango_attr_list_change().  A copy of attr
+// is inserted, replacing or merging with any overlapping attributes of
+// the same type.
+func (v *AttrList) Change(attr *Attribute) {
+	C.pango_attr_list_change(v.native(),
+		C.pango_attribute_copy(attr.native()))
+}
+
+// Splice is a wrapper around pango_attr_list_splice().  It opens a gap
+// of length bytes at pos and inserts the attributes of other, offset by
+// pos, into the gap.
+func (v *AttrList) Splice(other *AttrList, pos, length int) {
+	C.pango_attr_list_splice(v.native(), other.native(), C.gint(pos),
+		C.gint(length))
+}
+
+// GetIterator is a wrapper around pango_attr_list_get_iterator().  The
+// list must not be modified while the iterator is in use.
+func (v *AttrList) GetIterator() *AttrIterator {
+	c := C.pango_attr_list_get_iterator(v.native())
+	iter := &AttrIterator{c, v}
+	runtime.SetFinalizer(iter, (*AttrIterator).destroy)
+	return iter
+}
+
+/*
+ * PangoAttrIterator
+ */
+
+// AttrIterator is a representation of Pango's PangoAttrIterator, which
+// visits each segment of text over which the attributes of an AttrList
+// do not change.
+type AttrIterator struct {
+	attrIterator *C.PangoAttrIterator
+
+	// The iterated list must be kept alive as long as the iterator.
+	list *AttrList
+}
+
+// native returns a pointer to the underlying PangoAttrIterator.
+func (v *AttrIterator) native() *C.PangoAttrIterator {
+	if v == nil {
+		return nil
+	}
+	return v.attrIterator
+}
+
+// Native returns a pointer to the underlying PangoAttrIterator.
+func (v *AttrIterator) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+// destroy is a wrapper around pango_attr_iterator_destroy().
+func (v *AttrIterator) destroy() {
+	C.pango_attr_iterator_destroy(v.native())
+}
+
+// Next is a wrapper around pango_attr_iterator_next().  It returns false
+// if the iterator was already at the end of the list.
+func (v *AttrIterator) Next() bool {
+	c := C.pango_attr_iterator_next(v.native())
+	return gobool(c)
+}
+
+// Range is a wrapper around pango_attr_iterator_range().  It returns the
+// byte range of the current segment.  The end of the last segment is
+// the largest representable int.
+func (v *AttrIterator) Range() (start, end int) {
+	var cstart, cend C.gint
+	C.pango_attr_iterator_range(v.native(), &cstart, &cend)
+	return int(cstart), int(cend)
+}
+
+// Get is a wrapper around pango_attr_iterator_get().  It returns a copy
+// of the attribute of the given type applying to the current segment, or
+// nil if there is none.
+func (v *AttrIterator) Get(attrType AttrType) *Attribute {
+	c := C.pango_attr_iterator_get(v.native(), C.PangoAttrType(attrType))
+	if c == nil {
+		return nil
+	}
+	return takeAttribute(C.pango_attribute_copy(c))
+}
+
+// GetAttrs is a wrapper around pango_attr_iterator_get_attrs().  It
+// returns copies of all attributes applying to the current segment.
+func (v *AttrIterator) GetAttrs() []*Attribute {
+	list := C.pango_attr_iterator_get_attrs(v.native())
+	defer C.g_slist_free(list)
+	var attrs []*Attribute
+	for l := list; l != nil; l = l.next {
+		attrs = append(attrs, takeAttribute((*C.PangoAttribute)(l.data)))
+	}
+	return attrs
+}
+
+/*
+ * Markup
+ */
+
+// ParseMarkup is a wrapper around pango_parse_markup().  It returns the
+// text with markup removed and the attributes the markup describes.  If
+// accelMarker is not 0, the character following the first accelMarker
+// in the markup is returned as accelChar and underlined, and two
+// accelMarkers in a row produce a literal accelMarker.
+func ParseMarkup(markup string, accelMarker rune) (text string, attrs *AttrList, accelChar rune, err error) {
+	cstr := C.CString(markup)
+	defer C.free(unsafe.Pointer(cstr))
+	var cattrs *C.PangoAttrList
+	var ctext *C.char
+	var caccel C.gunichar
+	var cerr *C.GError
+	ok := C.pango_parse_markup(cstr, C.int(len(markup)),
+		C.gunichar(accelMarker), &cattrs, &ctext, &caccel, &cerr)
+	if !gobool(ok) {
+		defer C.g_error_free(cerr)
+		return "", nil, 0, errors.New(C.GoString((*C.char)(cerr.message)))
+	}
+	defer C.g_free(C.gpointer(ctext))
+	attrs = NewAttrList(uintptr(unsafe.Pointer(cattrs)), false)
+	return C.GoString(ctext), attrs, rune(caccel), nil
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package pango
+
+// #cgo pkg-config: pango
+// #include <pango/pango.h>
+// #include "pango.go.h"
+import "C"
+import (
+	"github.com/conformal/gotk3/glib"
+	"runtime"
+	"unsafe"
+)
+
+/*
+ * PangoContext
+ */
+
+// Context is a representation of Pango's PangoContext, which holds the
+// global information used to lay out text, such as the font map and
+// default font description.  Contexts are usually obtained from a GTK
+// widget or a Cairo font map rather than created directly.
+type Context struct {
+	*glib.Object
+}
+
+// native returns a pointer to the underlying PangoContext.
+func (v *Context) native() *C.PangoContext {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toPangoContext(p)
+}
+
+// Native returns a pointer to the underlying PangoContext.
+func (v *Context) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalContext(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := &glib.Object{glib.ToGObject(unsafe.Pointer(c))}
+	return wrapContext(obj), nil
+}
+
+func wrapContext(obj *glib.Object) *Context {
+	return &Context{obj}
+}
+
+// takeContext wraps a PangoContext already owned by the caller, releasing
+// the reference when the Go value is collected.
+func takeContext(c *C.PangoContext) *Context {
+	obj := &glib.Object{glib.ToGObject(unsafe.Pointer(c))}
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapContext(obj)
+}
+
+// refContext wraps a PangoContext owned by another object, adding a
+// reference for the lifetime of the Go value.
+func refContext(c *C.PangoContext) *Context {
+	obj := &glib.Object{glib.ToGObject(unsafe.Pointer(c))}
+	obj.Ref()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapContext(obj)
+}
+
+// ContextNew is a wrapper around pango_context_new().  The returned
+// Context has no font map, and cannot be used to lay out text until one
+// is set.
+func ContextNew() *Context {
+	c := C.pango_context_new()
+	return takeContext(c)
+}
+
+// SetFontDescription is a wrapper around
+// pango_context_set_font_description().
+func (v *Context) SetFontDescription(desc *FontDescription) {
+	C.pango_context_set_font_description(v.native(), desc.native())
+}
+
+// GetFontDescription is a wrapper around
+// pango_context_get_font_description().
+func (v *Context) GetFontDescription() *FontDescription {
+	c := C.pango_context_get_font_description(v.native())
+	if c == nil {
+		return nil
+	}
+	return takeFontDescription(C.pango_font_description_copy(c))
+}
+
+// SetFontMap is a wrapper around pango_context_set_font_map().
+func (v *Context) SetFontMap(fontMap *FontMap) {
+	C.pango_context_set_font_map(v.native(), fontMap.native())
+}
+
+// GetFontMap is a wrapper around pango_context_get_font_map().
+func (v *Context) GetFontMap() *FontMap {
+	c := C.pango_context_get_font_map(v.native())
+	if c == nil {
+		return nil
+	}
+	return refFontMap(c)
+}
+
+// ListFamilies is a wrapper around pango_context_list_families().
+func (v *Context) ListFamilies() []*FontFamily {
+	var cfamilies **C.PangoFontFamily
+	var n C.int
+	C.pango_context_list_families(v.native(), &cfamilies, &n)
+	defer C.g_free(C.gpointer(cfamilies))
+	return wrapFontFamilies(cfamilies, n)
+}
+
+// LoadFont is a wrapper around pango_context_load_font().  It returns
+// nil if no font matching desc could be loaded.
+func (v *Context) LoadFont(desc *FontDescription) *Font {
+	c := C.pango_context_load_font(v.native(), desc.native())
+	if c == nil {
+		return nil
+	}
+	return takeFont(c)
+}
+
+// GetMetrics is a wrapper around pango_context_get_metrics().  The
+// metrics are those of the fonts desc would select for the context's
+// default language.
+func (v *Context) GetMetrics(desc *FontDescription) *FontMetrics {
+	c := C.pango_context_get_metrics(v.native(), desc.native(), nil)
+	return takeFontMetrics(c)
+}
+
+// SetBaseGravity is a wrapper around pango_context_set_base_gravity().
+func (v *Context) SetBaseGravity(gravity Gravity) {
+	C.pango_context_set_base_gravity(v.native(), C.PangoGravity(gravity))
+}
+
+// GetBaseGravity is a wrapper around pango_context_get_base_gravity().
+func (v *Context) GetBaseGravity() Gravity {
+	c := C.pango_context_get_base_gravity(v.native())
+	return Gravity(c)
+}
+
+// GetGravity is a wrapper around pango_context_get_gravity().  It
+// returns the resolved gravity, which differs from the base gravity when
+// that is GRAVITY_AUTO.
+func (v *Context) GetGravity() Gravity {
+	c := C.pango_context_get_gravity(v.native())
+	return Gravity(c)
+}
+
+// SetGravityHint is a wrapper around pango_context_set_gravity_hint().
+func (v *Context) SetGravityHint(hint GravityHint) {
+	C.pango_context_set_gravity_hint(v.native(), C.PangoGravityHint(hint))
+}
+
+// GetGravityHint is a wrapper around pango_context_get_gravity_hint().
+func (v *Context) GetGravityHint() GravityHint {
+	c := C.pango_context_get_gravity_hint(v.native())
+	return GravityHint(c)
+}
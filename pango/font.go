@@ -0,0 +1,653 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package pango
+
+// #cgo pkg-config: pango
+// #include <stdlib.h>
+// #include <pango/pango.h>
+// #include "pango.go.h"
+import "C"
+import (
+	"github.com/conformal/gotk3/glib"
+	"reflect"
+	"runtime"
+	"unsafe"
+)
+
+/*
+ * PangoFontDescription
+ */
+
+// FontDescription is a representation of Pango's PangoFontDescription,
+// which describes a font by its family, style, weight and other
+// attributes.  Fields which have not been set are left for the font
+// system to choose; GetSetFields reports which fields are set.
+type FontDescription struct {
+	fontDescription *C.PangoFontDescription
+}
+
+// native returns a pointer to the underlying PangoFontDescription.
+func (v *FontDescription) native() *C.PangoFontDescription {
+	if v == nil {
+		return nil
+	}
+	return v.fontDescription
+}
+
+// Native returns a pointer to the underlying PangoFontDescription.
+func (v *FontDescription) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalFontDescription(p uintptr) (interface{}, error) {
+	c := C.g_value_get_boxed((*C.GValue)(unsafe.Pointer(p)))
+	desc := (*C.PangoFontDescription)(unsafe.Pointer(c))
+	// Font descriptions are not reference counted, so take a copy.
+	return takeFontDescription(C.pango_font_description_copy(desc)), nil
+}
+
+// takeFontDescription wraps a newly-created PangoFontDescription.
+func takeFontDescription(desc *C.PangoFontDescription) *FontDescription {
+	d := &FontDescription{desc}
+	runtime.SetFinalizer(d, (*FontDescription).free)
+	return d
+}
+
+// FontDescriptionNew is a wrapper around pango_font_description_new().
+func FontDescriptionNew() *FontDescription {
+	c := C.pango_font_description_new()
+	return takeFontDescription(c)
+}
+
+// FontDescriptionFromString is a wrapper around
+// pango_font_description_from_string().  The string has the form
+// "[FAMILY-LIST] [STYLE-OPTIONS] [SIZE]", for example "Sans Bold 12".
+func FontDescriptionFromString(str string) *FontDescription {
+	cstr := C.CString(str)
+	defer C.free(unsafe.Pointer(cstr))
+	c := C.pango_font_description_from_string(cstr)
+	return takeFontDescription(c)
+}
+
+// Copy is a wrapper around pango_font_description_copy().
+func (v *FontDescription) Copy() *FontDescription {
+	c := C.pango_font_description_copy(v.native())
+	return takeFontDescription(c)
+}
+
+// free is a wrapper around pango_font_description_free().
+func (v *FontDescription) free() {
+	C.pango_font_description_free(v.native())
+}
+
+// Equal is a wrapper around pango_font_description_equal().
+func (v *FontDescription) Equal(other *FontDescription) bool {
+	c := C.pango_font_description_equal(v.native(), other.native())
+	return gobool(c)
+}
+
+// Hash is a wrapper around pango_font_description_hash().
+func (v *FontDescription) Hash() uint {
+	c := C.pango_font_description_hash(v.native())
+	return uint(c)
+}
+
+// ToString is a wrapper around pango_font_description_to_string().
+func (v *FontDescription) ToString() string {
+	c := C.pango_font_description_to_string(v.native())
+	defer C.g_free(C.gpointer(c))
+	return C.GoString(c)
+}
+
+// ToFilename is a wrapper around pango_font_description_to_filename().
+func (v *FontDescription) ToFilename() string {
+	c := C.pango_font_description_to_filename(v.native())
+	defer C.g_free(C.gpointer(c))
+	return C.GoString(c)
+}
+
+// SetFamily is a wrapper around pango_font_description_set_family().
+// The family may be a comma separated list of families to try in turn.
+func (v *FontDescription) SetFamily(family string) {
+	cstr := C.CString(family)
+	defer C.free(unsafe.Pointer(cstr))
+	C.pango_font_description_set_family(v.native(), cstr)
+}
+
+// GetFamily is a wrapper around pango_font_description_get_family().  It
+// returns an empty string if the family is not set.
+func (v *FontDescription) GetFamily() string {
+	c := C.pango_font_description_get_family(v.native())
+	if c == nil {
+		return ""
+	}
+	return C.GoString(c)
+}
+
+// SetStyle is a wrapper around pango_font_description_set_style().
+func (v *FontDescription) SetStyle(style Style) {
+	C.pango_font_description_set_style(v.native(), C.PangoStyle(style))
+}
+
+// GetStyle is a wrapper around pango_font_description_get_style().
+func (v *FontDescription) GetStyle() Style {
+	c := C.pango_font_description_get_style(v.native())
+	return Style(c)
+}
+
+// SetVariant is a wrapper around pango_font_description_set_variant().
+func (v *FontDescription) SetVariant(variant Variant) {
+	C.pango_font_description_set_variant(v.native(),
+		C.PangoVariant(variant))
+}
+
+// GetVariant is a wrapper around pango_font_description_get_variant().
+func (v *FontDescription) GetVariant() Variant {
+	c := C.pango_font_description_get_variant(v.native())
+	return Variant(c)
+}
+
+// SetWeight is a wrapper around pango_font_description_set_weight().
+func (v *FontDescription) SetWeight(weight Weight) {
+	C.pango_font_description_set_weight(v.native(), C.PangoWeight(weight))
+}
+
+// GetWeight is a wrapper around pango_font_description_get_weight().
+func (v *FontDescription) GetWeight() Weight {
+	c := C.pango_font_description_get_weight(v.native())
+	return Weight(c)
+}
+
+// SetStretch is a wrapper around pango_font_description_set_stretch().
+func (v *FontDescription) SetStretch(stretch Stretch) {
+	C.pango_font_description_set_stretch(v.native(),
+		C.PangoStretch(stretch))
+}
+
+// GetStretch is a wrapper around pango_font_description_get_stretch().
+func (v *FontDescription) GetStretch() Stretch {
+	c := C.pango_font_description_get_stretch(v.native())
+	return Stretch(c)
+}
+
+// SetGravity is a wrapper around pango_font_description_set_gravity().
+func (v *FontDescription) SetGravity(gravity Gravity) {
+	C.pango_font_description_set_gravity(v.native(),
+		C.PangoGravity(gravity))
+}
+
+// GetGravity is a wrapper around pango_font_description_get_gravity().
+func (v *FontDescription) GetGravity() Gravity {
+	c := C.pango_font_description_get_gravity(v.native())
+	return Gravity(c)
+}
+
+// SetSize is a wrapper around pango_font_description_set_size().  The
+// size is given in points scaled by SCALE, so a 12 point font has a size
+// of 12 * SCALE.
+func (v *FontDescription) SetSize(size int) {
+	C.pango_font_description_set_size(v.native(), C.gint(size))
+}
+
+// SetAbsoluteSize is a wrapper around
+// pango_font_description_set_absolute_size().  The size is given in
+// device units scaled by SCALE.
+func (v *FontDescription) SetAbsoluteSize(size float64) {
+	C.pango_font_description_set_absolute_size(v.native(), C.double(size))
+}
+
+// GetSize is a wrapper around pango_font_description_get_size().  Use
+// GetSizeIsAbsolute to find whether the size is in points or device
+// units.
+func (v *FontDescription) GetSize() int {
+	c := C.pango_font_description_get_size(v.native())
+	return int(c)
+}
+
+// GetSizeIsAbsolute is a wrapper around
+// pango_font_description_get_size_is_absolute().
+func (v *FontDescription) GetSizeIsAbsolute() bool {
+	c := C.pango_font_description_get_size_is_absolute(v.native())
+	return gobool(c)
+}
+
+// GetSetFields is a wrapper around
+// pango_font_description_get_set_fields().
+func (v *FontDescription) GetSetFields() FontMask {
+	c := C.pango_font_description_get_set_fields(v.native())
+	return FontMask(c)
+}
+
+// UnsetFields is a wrapper around pango_font_description_unset_fields().
+func (v *FontDescription) UnsetFields(toUnset FontMask) {
+	C.pango_font_description_unset_fields(v.native(),
+		C.PangoFontMask(toUnset))
+}
+
+// Merge is a wrapper around pango_font_description_merge().  Fields set
+// in other are copied to v; if replaceExisting is false, only fields
+// which are unset in v are changed.
+func (v *FontDescription) Merge(other *FontDescription, replaceExisting bool) {
+	C.pango_font_description_merge(v.native(), other.native(),
+		gbool(replaceExisting))
+}
+
+/*
+ * PangoFontMap
+ */
+
+// FontMap is a representation of Pango's PangoFontMap, which represents
+// the set of fonts available for a particular rendering system.
+type FontMap struct {
+	*glib.Object
+}
+
+// native returns a pointer to the underlying PangoFontMap.
+func (v *FontMap) native() *C.PangoFontMap {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toPangoFontMap(p)
+}
+
+// Native returns a pointer to the underlying PangoFontMap.
+func (v *FontMap) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalFontMap(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := &glib.Object{glib.ToGObject(unsafe.Pointer(c))}
+	return wrapFontMap(obj), nil
+}
+
+func wrapFontMap(obj *glib.Object) *FontMap {
+	return &FontMap{obj}
+}
+
+// refFontMap wraps a PangoFontMap owned by another object, adding a
+// reference for the lifetime of the Go value.
+func refFontMap(c *C.PangoFontMap) *FontMap {
+	obj := &glib.Object{glib.ToGObject(unsafe.Pointer(c))}
+	obj.Ref()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapFontMap(obj)
+}
+
+// CreateContext is a wrapper around pango_font_map_create_context().
+func (v *FontMap) CreateContext() *Context {
+	c := C.pango_font_map_create_context(v.native())
+	return takeContext(c)
+}
+
+// LoadFont is a wrapper around pango_font_map_load_font().  It returns
+// nil if no font matching desc could be loaded.
+func (v *FontMap) LoadFont(context *Context, desc *FontDescription) *Font {
+	c := C.pango_font_map_load_font(v.native(), context.native(),
+		desc.native())
+	if c == nil {
+		return nil
+	}
+	return takeFont(c)
+}
+
+// ListFamilies is a wrapper around pango_font_map_list_families().
+func (v *FontMap) ListFamilies() []*FontFamily {
+	var cfamilies **C.PangoFontFamily
+	var n C.int
+	C.pango_font_map_list_families(v.native(), &cfamilies, &n)
+	defer C.g_free(C.gpointer(cfamilies))
+	return wrapFontFamilies(cfamilies, n)
+}
+
+/*
+ * PangoFontFamily
+ */
+
+// FontFamily is a representation of Pango's PangoFontFamily, a family
+// of related font faces such as the regular, bold and italic faces of a
+// font.
+type FontFamily struct {
+	*glib.Object
+}
+
+// native returns a pointer to the underlying PangoFontFamily.
+func (v *FontFamily) native() *C.PangoFontFamily {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toPangoFontFamily(p)
+}
+
+// Native returns a pointer to the underlying PangoFontFamily.
+func (v *FontFamily) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalFontFamily(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := &glib.Object{glib.ToGObject(unsafe.Pointer(c))}
+	return wrapFontFamily(obj), nil
+}
+
+func wrapFontFamily(obj *glib.Object) *FontFamily {
+	return &FontFamily{obj}
+}
+
+// wrapFontFamilies wraps an array of n PangoFontFamily pointers owned by
+// a font map, adding a reference to each.
+func wrapFontFamilies(cfamilies **C.PangoFontFamily, n C.int) []*FontFamily {
+	var families []*C.PangoFontFamily
+	header := (*reflect.SliceHeader)(unsafe.Pointer(&families))
+	header.Data = uintptr(unsafe.Pointer(cfamilies))
+	header.Len = int(n)
+	header.Cap = int(n)
+	s := make([]*FontFamily, 0, len(families))
+	for _, c := range families {
+		obj := &glib.Object{glib.ToGObject(unsafe.Pointer(c))}
+		obj.Ref()
+		runtime.SetFinalizer(obj, (*glib.Object).Unref)
+		s = append(s, wrapFontFamily(obj))
+	}
+	return s
+}
+
+// GetName is a wrapper around pango_font_family_get_name().
+func (v *FontFamily) GetName() string {
+	c := C.pango_font_family_get_name(v.native())
+	return C.GoString(c)
+}
+
+// IsMonospace is a wrapper around pango_font_family_is_monospace().
+func (v *FontFamily) IsMonospace() bool {
+	c := C.pango_font_family_is_monospace(v.native())
+	return gobool(c)
+}
+
+// ListFaces is a wrapper around pango_font_family_list_faces().
+func (v *FontFamily) ListFaces() []*FontFace {
+	var cfaces **C.PangoFontFace
+	var n C.int
+	C.pango_font_family_list_faces(v.native(), &cfaces, &n)
+	defer C.g_free(C.gpointer(cfaces))
+	var faces []*C.PangoFontFace
+	header := (*reflect.SliceHeader)(unsafe.Pointer(&faces))
+	header.Data = uintptr(unsafe.Pointer(cfaces))
+	header.Len = int(n)
+	header.Cap = int(n)
+	s := make([]*FontFace, 0, len(faces))
+	for _, c := range faces {
+		obj := &glib.Object{glib.ToGObject(unsafe.Pointer(c))}
+		obj.Ref()
+		runtime.SetFinalizer(obj, (*glib.Object).Unref)
+		s = append(s, wrapFontFace(obj))
+	}
+	return s
+}
+
+/*
+ * PangoFontFace
+ */
+
+// FontFace is a representation of Pango's PangoFontFace, a single face
+// of a FontFamily, such as "Bold Italic".
+type FontFace struct {
+	*glib.Object
+}
+
+// native returns a pointer to the underlying PangoFontFace.
+func (v *FontFace) native() *C.PangoFontFace {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toPangoFontFace(p)
+}
+
+// Native returns a pointer to the underlying PangoFontFace.
+func (v *FontFace) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalFontFace(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := &glib.Object{glib.ToGObject(unsafe.Pointer(c))}
+	return wrapFontFace(obj), nil
+}
+
+func wrapFontFace(obj *glib.Object) *FontFace {
+	return &FontFace{obj}
+}
+
+// GetFaceName is a wrapper around pango_font_face_get_face_name().
+func (v *FontFace) GetFaceName() string {
+	c := C.pango_font_face_get_face_name(v.native())
+	return C.GoString(c)
+}
+
+// Describe is a wrapper around pango_font_face_describe().  The returned
+// description has its family, style, variant, weight and stretch set.
+func (v *FontFace) Describe() *FontDescription {
+	c := C.pango_font_face_describe(v.native())
+	return takeFontDescription(c)
+}
+
+// IsSynthesized is a wrapper around pango_font_face_is_synthesized().  A
+// synthesized face is produced by transforming another face, such as by
+// slanting it to appear italic.
+func (v *FontFace) IsSynthesized() bool {
+	c := C.pango_font_face_is_synthesized(v.native())
+	return gobool(c)
+}
+
+// ListSizes is a wrapper around pango_font_face_list_sizes().  It returns
+// the available sizes of a bitmap font in Pango units, or nil for a
+// scalable font.
+func (v *FontFace) ListSizes() []int {
+	var csizes *C.int
+	var n C.int
+	C.pango_font_face_list_sizes(v.native(), &csizes, &n)
+	if csizes == nil {
+		return nil
+	}
+	defer C.g_free(C.gpointer(csizes))
+	var sizes []C.int
+	header := (*reflect.SliceHeader)(unsafe.Pointer(&sizes))
+	header.Data = uintptr(unsafe.Pointer(csizes))
+	header.Len = int(n)
+	header.Cap = int(n)
+	s := make([]int, 0, len(sizes))
+	for _, size := range sizes {
+		s = append(s, int(size))
+	}
+	return s
+}
+
+/*
+ * PangoFont
+ */
+
+// Font is a representation of Pango's PangoFont, a font loaded by a
+// FontMap for rendering.
+type Font struct {
+	*glib.Object
+}
+
+// native returns a pointer to the underlying PangoFont.
+func (v *Font) native() *C.PangoFont {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toPangoFont(p)
+}
+
+// Native returns a pointer to the underlying PangoFont.
+func (v *Font) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalFont(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := &glib.Object{glib.ToGObject(unsafe.Pointer(c))}
+	return wrapFont(obj), nil
+}
+
+func wrapFont(obj *glib.Object) *Font {
+	return &Font{obj}
+}
+
+// takeFont wraps a PangoFont already owned by the caller, releasing the
+// reference when the Go value is collected.
+func takeFont(c *C.PangoFont) *Font {
+	obj := &glib.Object{glib.ToGObject(unsafe.Pointer(c))}
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapFont(obj)
+}
+
+// refFont wraps a PangoFont owned by another object, adding a reference
+// for the lifetime of the Go value.
+func refFont(c *C.PangoFont) *Font {
+	obj := &glib.Object{glib.ToGObject(unsafe.Pointer(c))}
+	obj.Ref()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapFont(obj)
+}
+
+// Describe is a wrapper around pango_font_describe().
+func (v *Font) Describe() *FontDescription {
+	c := C.pango_font_describe(v.native())
+	return takeFontDescription(c)
+}
+
+// GetMetrics is a wrapper around pango_font_get_metrics().  The metrics
+// are computed for the entire font rather than for a single language.
+func (v *Font) GetMetrics() *FontMetrics {
+	c := C.pango_font_get_metrics(v.native(), nil)
+	return takeFontMetrics(c)
+}
+
+// GetFontMap is a wrapper around pango_font_get_font_map().  It returns
+// nil if the font map has been freed.
+func (v *Font) GetFontMap() *FontMap {
+	c := C.pango_font_get_font_map(v.native())
+	if c == nil {
+		return nil
+	}
+	return refFontMap(c)
+}
+
+/*
+ * PangoFontMetrics
+ */
+
+// FontMetrics is a representation of Pango's PangoFontMetrics.  All
+// metrics are given in Pango units.
+type FontMetrics struct {
+	fontMetrics *C.PangoFontMetrics
+}
+
+// native returns a pointer to the underlying PangoFontMetrics.
+func (v *FontMetrics) native() *C.PangoFontMetrics {
+	if v == nil {
+		return nil
+	}
+	return v.fontMetrics
+}
+
+// Native returns a pointer to the underlying PangoFontMetrics.
+func (v *FontMetrics) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalFontMetrics(p uintptr) (interface{}, error) {
+	c := C.g_value_get_boxed((*C.GValue)(unsafe.Pointer(p)))
+	metrics := (*C.PangoFontMetrics)(unsafe.Pointer(c))
+	return takeFontMetrics(C.pango_font_metrics_ref(metrics)), nil
+}
+
+// takeFontMetrics wraps a PangoFontMetrics already owned by the caller.
+func takeFontMetrics(metrics *C.PangoFontMetrics) *FontMetrics {
+	m := &FontMetrics{metrics}
+	runtime.SetFinalizer(m, (*FontMetrics).unref)
+	return m
+}
+
+// unref is a wrapper around pango_font_metrics_unref().
+func (v *FontMetrics) unref() {
+	C.pango_font_metrics_unref(v.native())
+}
+
+// GetAscent is a wrapper around pango_font_metrics_get_ascent().
+func (v *FontMetrics) GetAscent() int {
+	c := C.pango_font_metrics_get_ascent(v.native())
+	return int(c)
+}
+
+// GetDescent is a wrapper around pango_font_metrics_get_descent().
+func (v *FontMetrics) GetDescent() int {
+	c := C.pango_font_metrics_get_descent(v.native())
+	return int(c)
+}
+
+// GetApproximateCharWidth is a wrapper around
+// pango_font_metrics_get_approximate_char_width().
+func (v *FontMetrics) GetApproximateCharWidth() int {
+	c := C.pango_font_metrics_get_approximate_char_width(v.native())
+	return int(c)
+}
+
+// GetApproximateDigitWidth is a wrapper around
+// pango_font_metrics_get_approximate_digit_width().  It is suitable for
+// sizing columns of numbers.
+func (v *FontMetrics) GetApproximateDigitWidth() int {
+	c := C.pango_font_metrics_get_approximate_digit_width(v.native())
+	return int(c)
+}
+
+// GetUnderlinePosition is a wrapper around
+// pango_font_metrics_get_underline_position().  The position is the
+// distance above the baseline of the top of the underline, and is
+// usually negative.
+func (v *FontMetrics) GetUnderlinePosition() int {
+	c := C.pango_font_metrics_get_underline_position(v.native())
+	return int(c)
+}
+
+// GetUnderlineThickness is a wrapper around
+// pango_font_metrics_get_underline_thickness().
+func (v *FontMetrics) GetUnderlineThickness() int {
+	c := C.pango_font_metrics_get_underline_thickness(v.native())
+	return int(c)
+}
+
+// GetStrikethroughPosition is a wrapper around
+// pango_font_metrics_get_strikethrough_position().  The position is the
+// distance above the baseline of the top of the strikethrough.
+func (v *FontMetrics) GetStrikethroughPosition() int {
+	c := C.pango_font_metrics_get_strikethrough_position(v.native())
+	return int(c)
+}
+
+// GetStrikethroughThickness is a wrapper around
+// pango_font_metrics_get_strikethrough_thickness().
+func (v *FontMetrics) GetStrikethroughThickness() int {
+	c := C.pango_font_metrics_get_strikethrough_thickness(v.native())
+	return int(c)
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package pango
+
+// #cgo pkg-config: pango
+// #include <pango/pango.h>
+// #include "pango.go.h"
+//
+// static gboolean
+// _pango_glyph_info_is_cluster_start(PangoGlyphInfo *info)
+// {
+// 	return (info->attr.is_cluster_start);
+// }
+import "C"
+import (
+	"reflect"
+	"runtime"
+	"unsafe"
+)
+
+/*
+ * PangoGlyphString
+ */
+
+// GlyphInfo is a representation of Pango's PangoGlyphInfo, a single
+// positioned glyph.  Widths and offsets are given in Pango units.
+type GlyphInfo struct {
+	Glyph            uint32
+	Width            int
+	XOffset, YOffset int
+	IsClusterStart   bool
+}
+
+// GlyphString is a representation of Pango's PangoGlyphString, the
+// glyphs produced by shaping a run of text.
+type GlyphString struct {
+	glyphString *C.PangoGlyphString
+}
+
+// native returns a pointer to the underlying PangoGlyphString.
+func (v *GlyphString) native() *C.PangoGlyphString {
+	if v == nil {
+		return nil
+	}
+	return v.glyphString
+}
+
+// Native returns a pointer to the underlying PangoGlyphString.
+func (v *GlyphString) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalGlyphString(p uintptr) (interface{}, error) {
+	c := C.g_value_get_boxed((*C.GValue)(unsafe.Pointer(p)))
+	glyphs := (*C.PangoGlyphString)(unsafe.Pointer(c))
+	return takeGlyphString(C.pango_glyph_string_copy(glyphs)), nil
+}
+
+// takeGlyphString wraps a newly-created PangoGlyphString.
+func takeGlyphString(glyphs *C.PangoGlyphString) *GlyphString {
+	g := &GlyphString{glyphs}
+	runtime.SetFinalizer(g, (*GlyphString).free)
+	return g
+}
+
+// free is a wrapper around pango_glyph_string_free().
+func (v *GlyphString) free() {
+	C.pango_glyph_string_free(v.native())
+}
+
+// Copy is a wrapper around pango_glyph_string_copy().
+func (v *GlyphString) Copy() *GlyphString {
+	c := C.pango_glyph_string_copy(v.native())
+	return takeGlyphString(c)
+}
+
+// GetNumGlyphs returns the number of glyphs in the string.
+func (v *GlyphString) GetNumGlyphs() int {
+	return int(v.native().num_glyphs)
+}
+
+// GetGlyphs returns the glyphs of the string.
+func (v *GlyphString) GetGlyphs() []GlyphInfo {
+	n := v.GetNumGlyphs()
+	var infos []C.PangoGlyphInfo
+	header := (*reflect.SliceHeader)(unsafe.Pointer(&infos))
+	header.Data = uintptr(unsafe.Pointer(v.native().glyphs))
+	header.Len = n
+	header.Cap = n
+	s := make([]GlyphInfo, 0, n)
+	for i := range infos {
+		info := &infos[i]
+		s = append(s, GlyphInfo{
+			Glyph:          uint32(info.glyph),
+			Width:          int(info.geometry.width),
+			XOffset:        int(info.geometry.x_offset),
+			YOffset:        int(info.geometry.y_offset),
+			IsClusterStart: gobool(C._pango_glyph_info_is_cluster_start(info)),
+		})
+	}
+	return s
+}
+
+// GetLogClusters returns, for each glyph, the byte index of the start of
+// its cluster relative to the start of the shaped text.
+func (v *GlyphString) GetLogClusters() []int {
+	n := v.GetNumGlyphs()
+	var clusters []C.gint
+	header := (*reflect.SliceHeader)(unsafe.Pointer(&clusters))
+	header.Data = uintptr(unsafe.Pointer(v.native().log_clusters))
+	header.Len = n
+	header.Cap = n
+	s := make([]int, 0, n)
+	for _, c := range clusters {
+		s = append(s, int(c))
+	}
+	return s
+}
+
+// GetWidth is a wrapper around pango_glyph_string_get_width().  It
+// returns the logical width of the string in Pango units.
+func (v *GlyphString) GetWidth() int {
+	c := C.pango_glyph_string_get_width(v.native())
+	return int(c)
+}
+
+// Extents is a wrapper around pango_glyph_string_extents().  It returns
+// the ink and logical extents of the string when rendered with font.
+func (v *GlyphString) Extents(font *Font) (ink, logical Rectangle) {
+	var cink, clogical C.PangoRectangle
+	C.pango_glyph_string_extents(v.native(), font.native(), &cink,
+		&clogical)
+	return wrapRectangle(&cink), wrapRectangle(&clogical)
+}
+
+/*
+ * PangoGlyphItem
+ */
+
+// GlyphItem is a representation of Pango's PangoGlyphItem, a run of text
+// shaped with a single font.  The runs of a LayoutLine are GlyphItems.
+type GlyphItem struct {
+	glyphItem *C.PangoGlyphItem
+}
+
+// native returns a pointer to the underlying PangoGlyphItem.
+func (v *GlyphItem) native() *C.PangoGlyphItem {
+	if v == nil {
+		return nil
+	}
+	return v.glyphItem
+}
+
+// Native returns a pointer to the underlying PangoGlyphItem.
+func (v *GlyphItem) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalGlyphItem(p uintptr) (interface{}, error) {
+	c := C.g_value_get_boxed((*C.GValue)(unsafe.Pointer(p)))
+	item := (*C.PangoGlyphItem)(unsafe.Pointer(c))
+	return takeGlyphItem(C.pango_glyph_item_copy(item)), nil
+}
+
+// takeGlyphItem wraps a newly-created PangoGlyphItem.
+func takeGlyphItem(item *C.PangoGlyphItem) *GlyphItem {
+	g := &GlyphItem{item}
+	runtime.SetFinalizer(g, (*GlyphItem).free)
+	return g
+}
+
+// free is a wrapper around pango_glyph_item_free().
+func (v *GlyphItem) free() {
+	C.pango_glyph_item_free(v.native())
+}
+
+// Copy is a wrapper around pango_glyph_item_copy().
+func (v *GlyphItem) Copy() *GlyphItem {
+	c := C.pango_glyph_item_copy(v.native())
+	return takeGlyphItem(c)
+}
+
+// GetOffset returns the byte offset of the start of the run in the text
+// of its layout.
+func (v *GlyphItem) GetOffset() int {
+	return int(v.native().item.offset)
+}
+
+// GetLength returns the length of the run in bytes.
+func (v *GlyphItem) GetLength() int {
+	return int(v.native().item.length)
+}
+
+// GetNumChars returns the length of the run in characters.
+func (v *GlyphItem) GetNumChars() int {
+	return int(v.native().item.num_chars)
+}
+
+// GetFont returns the font the run is shaped with.
+func (v *GlyphItem) GetFont() *Font {
+	c := v.native().item.analysis.font
+	if c == nil {
+		return nil
+	}
+	return refFont(c)
+}
+
+// GetGlyphs returns a copy of the glyphs of the run.
+func (v *GlyphItem) GetGlyphs() *GlyphString {
+	c := C.pango_glyph_string_copy(v.native().glyphs)
+	return takeGlyphString(c)
+}
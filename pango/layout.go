@@ -0,0 +1,707 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package pango
+
+// #cgo pkg-config: pango
+// #include <stdlib.h>
+// #include <pango/pango.h>
+// #include "pango.go.h"
+//
+// static gboolean
+// _pango_layout_line_is_paragraph_start(PangoLayoutLine *line)
+// {
+// 	return (line->is_paragraph_start);
+// }
+import "C"
+import (
+	"github.com/conformal/gotk3/glib"
+	"runtime"
+	"unsafe"
+)
+
+/*
+ * PangoLayout
+ */
+
+// Layout is a representation of Pango's PangoLayout.  A Layout lays out a
+// paragraph of text with a single font context.  Unless noted otherwise,
+// widths, positions and extents are given in Pango units, and byte
+// indexes are offsets into the UTF-8 text of the layout.
+type Layout struct {
+	*glib.Object
+}
+
+// native returns a pointer to the underlying PangoLayout.
+func (v *Layout) native() *C.PangoLayout {
+	if v == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toPangoLayout(p)
+}
+
+// Native returns a pointer to the underlying PangoLayout.
+func (v *Layout) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalLayout(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := &glib.Object{glib.ToGObject(unsafe.Pointer(c))}
+	return wrapLayout(obj), nil
+}
+
+func wrapLayout(obj *glib.Object) *Layout {
+	return &Layout{obj}
+}
+
+// takeLayout wraps a PangoLayout already owned by the caller, releasing
+// the reference when the Go value is collected.
+func takeLayout(c *C.PangoLayout) *Layout {
+	obj := &glib.Object{glib.ToGObject(unsafe.Pointer(c))}
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapLayout(obj)
+}
+
+// refLayout wraps a PangoLayout owned by another object, adding a
+// reference for the lifetime of the Go value.
+func refLayout(c *C.PangoLayout) *Layout {
+	obj := &glib.Object{glib.ToGObject(unsafe.Pointer(c))}
+	obj.Ref()
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return wrapLayout(obj)
+}
+
+// LayoutNew is a wrapper around pango_layout_new().
+func LayoutNew(context *Context) *Layout {
+	c := C.pango_layout_new(context.native())
+	return takeLayout(c)
+}
+
+// Copy is a wrapper around pango_layout_copy().
+func (v *Layout) Copy() *Layout {
+	c := C.pango_layout_copy(v.native())
+	return takeLayout(c)
+}
+
+// GetContext is a wrapper around pango_layout_get_context().
+func (v *Layout) GetContext() *Context {
+	c := C.pango_layout_get_context(v.native())
+	return refContext(c)
+}
+
+// ContextChanged is a wrapper around pango_layout_context_changed().  It
+// must be called after changing the Context used by the layout.
+func (v *Layout) ContextChanged() {
+	C.pango_layout_context_changed(v.native())
+}
+
+// SetText is a wrapper around pango_layout_set_text().
+func (v *Layout) SetText(text string) {
+	cstr := C.CString(text)
+	defer C.free(unsafe.Pointer(cstr))
+	C.pango_layout_set_text(v.native(), cstr, C.int(len(text)))
+}
+
+// GetText is a wrapper around pango_layout_get_text().
+func (v *Layout) GetText() string {
+	c := C.pango_layout_get_text(v.native())
+	return C.GoString(c)
+}
+
+// SetMarkup is a wrapper around pango_layout_set_markup().  The markup
+// must be valid Pango markup; invalid markup is reported by Pango as a
+// warning and leaves the layout text empty.
+func (v *Layout) SetMarkup(markup string) {
+	cstr := C.CString(markup)
+	defer C.free(unsafe.Pointer(cstr))
+	C.pango_layout_set_markup(v.native(), cstr, C.int(len(markup)))
+}
+
+// SetAttributes is a wrapper around pango_layout_set_attributes().
+// Passing nil removes the layout's attributes.
+func (v *Layout) SetAttributes(attrs *AttrList) {
+	C.pango_layout_set_attributes(v.native(), attrs.native())
+}
+
+// GetAttributes is a wrapper around pango_layout_get_attributes().  It
+// returns nil if the layout has no attributes.
+func (v *Layout) GetAttributes() *AttrList {
+	c := C.pango_layout_get_attributes(v.native())
+	return NewAttrList(uintptr(unsafe.Pointer(c)), true)
+}
+
+// SetFontDescription is a wrapper around
+// pango_layout_set_font_description().  Passing nil unsets the layout's
+// font description, so the Context's description is used instead.
+func (v *Layout) SetFontDescription(desc *FontDescription) {
+	C.pango_layout_set_font_description(v.native(), desc.native())
+}
+
+// GetFontDescription is a wrapper around
+// pango_layout_get_font_description().  It returns nil if the layout
+// uses the font description of its Context.
+func (v *Layout) GetFontDescription() *FontDescription {
+	c := C.pango_layout_get_font_description(v.native())
+	if c == nil {
+		return nil
+	}
+	return takeFontDescription(C.pango_font_description_copy(c))
+}
+
+// SetWidth is a wrapper around pango_layout_set_width().  A width of -1
+// disables wrapping and ellipsization.
+func (v *Layout) SetWidth(width int) {
+	C.pango_layout_set_width(v.native(), C.int(width))
+}
+
+// GetWidth is a wrapper around pango_layout_get_width().
+func (v *Layout) GetWidth() int {
+	c := C.pango_layout_get_width(v.native())
+	return int(c)
+}
+
+// SetHeight is a wrapper around pango_layout_set_height().  A positive
+// height is the maximum height in Pango units, while a negative height
+// is the maximum number of lines per paragraph.  The height is only
+// used when ellipsizing.
+func (v *Layout) SetHeight(height int) {
+	C.pango_layout_set_height(v.native(), C.int(height))
+}
+
+// GetHeight is a wrapper around pango_layout_get_height().
+func (v *Layout) GetHeight() int {
+	c := C.pango_layout_get_height(v.native())
+	return int(c)
+}
+
+// SetWrap is a wrapper around pango_layout_set_wrap().
+func (v *Layout) SetWrap(wrap WrapMode) {
+	C.pango_layout_set_wrap(v.native(), C.PangoWrapMode(wrap))
+}
+
+// GetWrap is a wrapper around pango_layout_get_wrap().
+func (v *Layout) GetWrap() WrapMode {
+	c := C.pango_layout_get_wrap(v.native())
+	return WrapMode(c)
+}
+
+// IsWrapped is a wrapper around pango_layout_is_wrapped().
+func (v *Layout) IsWrapped() bool {
+	c := C.pango_layout_is_wrapped(v.native())
+	return gobool(c)
+}
+
+// SetEllipsize is a wrapper around pango_layout_set_ellipsize().
+func (v *Layout) SetEllipsize(ellipsize EllipsizeMode) {
+	C.pango_layout_set_ellipsize(v.native(), C.PangoEllipsizeMode(ellipsize))
+}
+
+// GetEllipsize is a wrapper around pango_layout_get_ellipsize().
+func (v *Layout) GetEllipsize() EllipsizeMode {
+	c := C.pango_layout_get_ellipsize(v.native())
+	return EllipsizeMode(c)
+}
+
+// IsEllipsized is a wrapper around pango_layout_is_ellipsized().
+func (v *Layout) IsEllipsized() bool {
+	c := C.pango_layout_is_ellipsized(v.native())
+	return gobool(c)
+}
+
+// SetIndent is a wrapper around pango_layout_set_indent().  A negative
+// indent produces a hanging indentation.
+func (v *Layout) SetIndent(indent int) {
+	C.pango_layout_set_indent(v.native(), C.int(indent))
+}
+
+// GetIndent is a wrapper around pango_layout_get_indent().
+func (v *Layout) GetIndent() int {
+	c := C.pango_layout_get_indent(v.native())
+	return int(c)
+}
+
+// SetSpacing is a wrapper around pango_layout_set_spacing().
+func (v *Layout) SetSpacing(spacing int) {
+	C.pango_layout_set_spacing(v.native(), C.int(spacing))
+}
+
+// GetSpacing is a wrapper around pango_layout_get_spacing().
+func (v *Layout) GetSpacing() int {
+	c := C.pango_layout_get_spacing(v.native())
+	return int(c)
+}
+
+// SetJustify is a wrapper around pango_layout_set_justify().
+func (v *Layout) SetJustify(justify bool) {
+	C.pango_layout_set_justify(v.native(), gbool(justify))
+}
+
+// GetJustify is a wrapper around pango_layout_get_justify().
+func (v *Layout) GetJustify() bool {
+	c := C.pango_layout_get_justify(v.native())
+	return gobool(c)
+}
+
+// SetAutoDir is a wrapper around pango_layout_set_auto_dir().
+func (v *Layout) SetAutoDir(autoDir bool) {
+	C.pango_layout_set_auto_dir(v.native(), gbool(autoDir))
+}
+
+// GetAutoDir is a wrapper around pango_layout_get_auto_dir().
+func (v *Layout) GetAutoDir() bool {
+	c := C.pango_layout_get_auto_dir(v.native())
+	return gobool(c)
+}
+
+// SetAlignment is a wrapper around pango_layout_set_alignment().
+func (v *Layout) SetAlignment(alignment Alignment) {
+	C.pango_layout_set_alignment(v.native(), C.PangoAlignment(alignment))
+}
+
+// GetAlignment is a wrapper around pango_layout_get_alignment().
+func (v *Layout) GetAlignment() Alignment {
+	c := C.pango_layout_get_alignment(v.native())
+	return Alignment(c)
+}
+
+// SetSingleParagraphMode is a wrapper around
+// pango_layout_set_single_paragraph_mode().
+func (v *Layout) SetSingleParagraphMode(setting bool) {
+	C.pango_layout_set_single_paragraph_mode(v.native(), gbool(setting))
+}
+
+// GetSingleParagraphMode is a wrapper around
+// pango_layout_get_single_paragraph_mode().
+func (v *Layout) GetSingleParagraphMode() bool {
+	c := C.pango_layout_get_single_paragraph_mode(v.native())
+	return gobool(c)
+}
+
+// SetTabs is a wrapper around pango_layout_set_tabs().  Passing nil
+// restores the default tab stops, every 8 spaces.
+func (v *Layout) SetTabs(tabs *TabArray) {
+	C.pango_layout_set_tabs(v.native(), tabs.native())
+}
+
+// GetTabs is a wrapper around pango_layout_get_tabs().  It returns nil
+// if the default tab stops are used.
+func (v *Layout) GetTabs() *TabArray {
+	c := C.pango_layout_get_tabs(v.native())
+	return NewTabArray(uintptr(unsafe.Pointer(c)), false)
+}
+
+// GetIter is a wrapper around pango_layout_get_iter().  The iterator
+// starts at the first run of the first line.
+func (v *Layout) GetIter() *LayoutIter {
+	c := C.pango_layout_get_iter(v.native())
+	return takeLayoutIter(c)
+}
+
+// GetLine is a wrapper around pango_layout_get_line_readonly().  Lines
+// are counted from 0, and nil is returned if line is out of range.  The
+// returned line must not be used after the layout is modified.
+func (v *Layout) GetLine(line int) *LayoutLine {
+	c := C.pango_layout_get_line_readonly(v.native(), C.int(line))
+	if c == nil {
+		return nil
+	}
+	return refLayoutLine(c, v)
+}
+
+// GetLines is a wrapper around pango_layout_get_lines_readonly().  The
+// returned lines must not be used after the layout is modified.
+func (v *Layout) GetLines() []*LayoutLine {
+	var lines []*LayoutLine
+	for l := C.pango_layout_get_lines_readonly(v.native()); l != nil; l = l.next {
+		lines = append(lines, refLayoutLine((*C.PangoLayoutLine)(l.data), v))
+	}
+	return lines
+}
+
+// GetExtents is a wrapper around pango_layout_get_extents().  It returns
+// the ink and logical extents of the layout in Pango units.
+func (v *Layout) GetExtents() (ink, logical Rectangle) {
+	var cink, clogical C.PangoRectangle
+	C.pango_layout_get_extents(v.native(), &cink, &clogical)
+	return wrapRectangle(&cink), wrapRectangle(&clogical)
+}
+
+// GetPixelExtents is a wrapper around pango_layout_get_pixel_extents().
+// It returns the ink and logical extents of the layout in pixels.
+func (v *Layout) GetPixelExtents() (ink, logical Rectangle) {
+	var cink, clogical C.PangoRectangle
+	C.pango_layout_get_pixel_extents(v.native(), &cink, &clogical)
+	return wrapRectangle(&cink), wrapRectangle(&clogical)
+}
+
+// GetSize is a wrapper around pango_layout_get_size().  It returns the
+// logical width and height of the layout in Pango units.
+func (v *Layout) GetSize() (width, height int) {
+	var cwidth, cheight C.int
+	C.pango_layout_get_size(v.native(), &cwidth, &cheight)
+	return int(cwidth), int(cheight)
+}
+
+// GetPixelSize is a wrapper around pango_layout_get_pixel_size().  It
+// returns the logical width and height of the layout in pixels.
+func (v *Layout) GetPixelSize() (width, height int) {
+	var cwidth, cheight C.int
+	C.pango_layout_get_pixel_size(v.native(), &cwidth, &cheight)
+	return int(cwidth), int(cheight)
+}
+
+// GetBaseline is a wrapper around pango_layout_get_baseline().
+func (v *Layout) GetBaseline() int {
+	c := C.pango_layout_get_baseline(v.native())
+	return int(c)
+}
+
+// GetLineCount is a wrapper around pango_layout_get_line_count().
+func (v *Layout) GetLineCount() int {
+	c := C.pango_layout_get_line_count(v.native())
+	return int(c)
+}
+
+// IndexToPos is a wrapper around pango_layout_index_to_pos().  It returns
+// the logical rectangle of the grapheme at index.
+func (v *Layout) IndexToPos(index int) Rectangle {
+	var cpos C.PangoRectangle
+	C.pango_layout_index_to_pos(v.native(), C.int(index), &cpos)
+	return wrapRectangle(&cpos)
+}
+
+// IndexToLineX is a wrapper around pango_layout_index_to_line_x().  It
+// returns the line containing index, counting from 0, and the X position
+// of the leading or trailing edge of the grapheme.
+func (v *Layout) IndexToLineX(index int, trailing bool) (line, xPos int) {
+	var cline, cxPos C.int
+	C.pango_layout_index_to_line_x(v.native(), C.int(index),
+		gbool(trailing), &cline, &cxPos)
+	return int(cline), int(cxPos)
+}
+
+// XYToIndex is a wrapper around pango_layout_xy_to_index().  It returns
+// the index of the grapheme at the given position and the number of
+// characters from its leading edge that the position is closest to.
+// If the position is outside of the layout, the nearest index is
+// returned and inside is false.
+func (v *Layout) XYToIndex(x, y int) (index, trailing int, inside bool) {
+	var cindex, ctrailing C.int
+	c := C.pango_layout_xy_to_index(v.native(), C.int(x), C.int(y),
+		&cindex, &ctrailing)
+	return int(cindex), int(ctrailing), gobool(c)
+}
+
+// GetCursorPos is a wrapper around pango_layout_get_cursor_pos().  It
+// returns the strong and weak cursor positions for the insertion point
+// at index.
+func (v *Layout) GetCursorPos(index int) (strong, weak Rectangle) {
+	var cstrong, cweak C.PangoRectangle
+	C.pango_layout_get_cursor_pos(v.native(), C.int(index), &cstrong,
+		&cweak)
+	return wrapRectangle(&cstrong), wrapRectangle(&cweak)
+}
+
+/*
+ * PangoLayoutLine
+ */
+
+// LayoutLine is a representation of Pango's PangoLayoutLine, a single
+// line of a Layout.  Positions are relative to the left edge of the line
+// and byte indexes are offsets into the text of the layout.
+type LayoutLine struct {
+	layoutLine *C.PangoLayoutLine
+
+	// The layout of the line, or nil.  Holding the layout keeps it
+	// alive for as long as the line is used.
+	layout *Layout
+}
+
+// native returns a pointer to the underlying PangoLayoutLine.
+func (v *LayoutLine) native() *C.PangoLayoutLine {
+	if v == nil {
+		return nil
+	}
+	return v.layoutLine
+}
+
+// Native returns a pointer to the underlying PangoLayoutLine.
+func (v *LayoutLine) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalLayoutLine(p uintptr) (interface{}, error) {
+	c := C.g_value_get_boxed((*C.GValue)(unsafe.Pointer(p)))
+	if c == nil {
+		return (*LayoutLine)(nil), nil
+	}
+	line := (*C.PangoLayoutLine)(unsafe.Pointer(c))
+	// Lines removed from their layout have no layout.
+	var layout *Layout
+	if line.layout != nil {
+		layout = refLayout(line.layout)
+	}
+	return refLayoutLine(line, layout), nil
+}
+
+// refLayoutLine wraps a PangoLayoutLine of layout, adding a reference for
+// the lifetime of the Go value.
+func refLayoutLine(line *C.PangoLayoutLine, layout *Layout) *LayoutLine {
+	l := &LayoutLine{C.pango_layout_line_ref(line), layout}
+	runtime.SetFinalizer(l, (*LayoutLine).unref)
+	return l
+}
+
+// unref is a wrapper around pango_layout_line_unref().
+func (v *LayoutLine) unref() {
+	C.pango_layout_line_unref(v.native())
+}
+
+// GetStartIndex returns the byte index of the start of the line.
+func (v *LayoutLine) GetStartIndex() int {
+	return int(v.native().start_index)
+}
+
+// GetLength returns the length of the line in bytes.
+func (v *LayoutLine) GetLength() int {
+	return int(v.native().length)
+}
+
+// IsParagraphStart returns whether the line is the first line of a
+// paragraph.
+func (v *LayoutLine) IsParagraphStart() bool {
+	c := C._pango_layout_line_is_paragraph_start(v.native())
+	return gobool(c)
+}
+
+// GetRuns returns copies of the runs of the line, in visual order.
+func (v *LayoutLine) GetRuns() []*GlyphItem {
+	var runs []*GlyphItem
+	for l := v.native().runs; l != nil; l = l.next {
+		c := C.pango_glyph_item_copy((*C.PangoGlyphItem)(l.data))
+		runs = append(runs, takeGlyphItem(c))
+	}
+	return runs
+}
+
+// GetExtents is a wrapper around pango_layout_line_get_extents().  The
+// extents are relative to the baseline of the line.
+func (v *LayoutLine) GetExtents() (ink, logical Rectangle) {
+	var cink, clogical C.PangoRectangle
+	C.pango_layout_line_get_extents(v.native(), &cink, &clogical)
+	return wrapRectangle(&cink), wrapRectangle(&clogical)
+}
+
+// GetPixelExtents is a wrapper around
+// pango_layout_line_get_pixel_extents().
+func (v *LayoutLine) GetPixelExtents() (ink, logical Rectangle) {
+	var cink, clogical C.PangoRectangle
+	C.pango_layout_line_get_pixel_extents(v.native(), &cink, &clogical)
+	return wrapRectangle(&cink), wrapRectangle(&clogical)
+}
+
+// XToIndex is a wrapper around pango_layout_line_x_to_index().  It
+// returns the index of the grapheme at xPos and the number of
+// characters from its leading edge that xPos is closest to.  If xPos is
+// outside of the line, the nearest index is returned and inside is
+// false.
+func (v *LayoutLine) XToIndex(xPos int) (index, trailing int, inside bool) {
+	var cindex, ctrailing C.int
+	c := C.pango_layout_line_x_to_index(v.native(), C.int(xPos), &cindex,
+		&ctrailing)
+	return int(cindex), int(ctrailing), gobool(c)
+}
+
+// IndexToX is a wrapper around pango_layout_line_index_to_x().  It
+// returns the position of the leading or trailing edge of the grapheme
+// at index.
+func (v *LayoutLine) IndexToX(index int, trailing bool) int {
+	var cxPos C.int
+	C.pango_layout_line_index_to_x(v.native(), C.int(index),
+		gbool(trailing), &cxPos)
+	return int(cxPos)
+}
+
+/*
+ * PangoLayoutIter
+ */
+
+// LayoutIter is a representation of Pango's PangoLayoutIter, which walks
+// the lines, runs, clusters and characters of a Layout in visual order.
+// Extents are given in layout coordinates and Pango units.  The iterator
+// must not be used after the layout is modified.
+type LayoutIter struct {
+	layoutIter *C.PangoLayoutIter
+}
+
+// native returns a pointer to the underlying PangoLayoutIter.
+func (v *LayoutIter) native() *C.PangoLayoutIter {
+	if v == nil {
+		return nil
+	}
+	return v.layoutIter
+}
+
+// Native returns a pointer to the underlying PangoLayoutIter.
+func (v *LayoutIter) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalLayoutIter(p uintptr) (interface{}, error) {
+	c := C.g_value_get_boxed((*C.GValue)(unsafe.Pointer(p)))
+	iter := (*C.PangoLayoutIter)(unsafe.Pointer(c))
+	return takeLayoutIter(C.pango_layout_iter_copy(iter)), nil
+}
+
+// takeLayoutIter wraps a newly-created PangoLayoutIter.
+func takeLayoutIter(iter *C.PangoLayoutIter) *LayoutIter {
+	i := &LayoutIter{iter}
+	runtime.SetFinalizer(i, (*LayoutIter).free)
+	return i
+}
+
+// free is a wrapper around pango_layout_iter_free().
+func (v *LayoutIter) free() {
+	C.pango_layout_iter_free(v.native())
+}
+
+// Copy is a wrapper around pango_layout_iter_copy().
+func (v *LayoutIter) Copy() *LayoutIter {
+	c := C.pango_layout_iter_copy(v.native())
+	return takeLayoutIter(c)
+}
+
+// GetLayout is a wrapper around pango_layout_iter_get_layout().
+func (v *LayoutIter) GetLayout() *Layout {
+	c := C.pango_layout_iter_get_layout(v.native())
+	return refLayout(c)
+}
+
+// NextLine is a wrapper around pango_layout_iter_next_line().  It
+// returns false if the iterator was already on the last line.
+func (v *LayoutIter) NextLine() bool {
+	c := C.pango_layout_iter_next_line(v.native())
+	return gobool(c)
+}
+
+// NextRun is a wrapper around pango_layout_iter_next_run().  Each line
+// ends with an empty run, for which GetRun returns nil.  It returns false
+// if the iterator was already at the end of the layout.
+func (v *LayoutIter) NextRun() bool {
+	c := C.pango_layout_iter_next_run(v.native())
+	return gobool(c)
+}
+
+// NextCluster is a wrapper around pango_layout_iter_next_cluster().  It
+// returns false if the iterator was already at the end of the layout.
+func (v *LayoutIter) NextCluster() bool {
+	c := C.pango_layout_iter_next_cluster(v.native())
+	return gobool(c)
+}
+
+// NextChar is a wrapper around pango_layout_iter_next_char().  It
+// returns false if the iterator was already at the end of the layout.
+func (v *LayoutIter) NextChar() bool {
+	c := C.pango_layout_iter_next_char(v.native())
+	return gobool(c)
+}
+
+// AtLastLine is a wrapper around pango_layout_iter_at_last_line().
+func (v *LayoutIter) AtLastLine() bool {
+	c := C.pango_layout_iter_at_last_line(v.native())
+	return gobool(c)
+}
+
+// GetIndex is a wrapper around pango_layout_iter_get_index().  It
+// returns the byte index of the current position.
+func (v *LayoutIter) GetIndex() int {
+	c := C.pango_layout_iter_get_index(v.native())
+	return int(c)
+}
+
+// GetBaseline is a wrapper around pango_layout_iter_get_baseline().  It
+// returns the Y position of the baseline of the current line.
+func (v *LayoutIter) GetBaseline() int {
+	c := C.pango_layout_iter_get_baseline(v.native())
+	return int(c)
+}
+
+// GetLine is a wrapper around pango_layout_iter_get_line_readonly().
+func (v *LayoutIter) GetLine() *LayoutLine {
+	c := C.pango_layout_iter_get_line_readonly(v.native())
+	return refLayoutLine(c, v.GetLayout())
+}
+
+// GetRun is a wrapper around pango_layout_iter_get_run_readonly().  It
+// returns a copy of the current run, or nil at the end of a line.
+func (v *LayoutIter) GetRun() *GlyphItem {
+	c := C.pango_layout_iter_get_run_readonly(v.native())
+	if c == nil {
+		return nil
+	}
+	return takeGlyphItem(C.pango_glyph_item_copy(c))
+}
+
+// GetCharExtents is a wrapper around
+// pango_layout_iter_get_char_extents().  It returns the logical extents
+// of the current character.
+func (v *LayoutIter) GetCharExtents() Rectangle {
+	var clogical C.PangoRectangle
+	C.pango_layout_iter_get_char_extents(v.native(), &clogical)
+	return wrapRectangle(&clogical)
+}
+
+// GetClusterExtents is a wrapper around
+// pango_layout_iter_get_cluster_extents().
+func (v *LayoutIter) GetClusterExtents() (ink, logical Rectangle) {
+	var cink, clogical C.PangoRectangle
+	C.pango_layout_iter_get_cluster_extents(v.native(), &cink, &clogical)
+	return wrapRectangle(&cink), wrapRectangle(&clogical)
+}
+
+// GetRunExtents is a wrapper around pango_layout_iter_get_run_extents().
+func (v *LayoutIter) GetRunExtents() (ink, logical Rectangle) {
+	var cink, clogical C.PangoRectangle
+	C.pango_layout_iter_get_run_extents(v.native(), &cink, &clogical)
+	return wrapRectangle(&cink), wrapRectangle(&clogical)
+}
+
+// GetLineExtents is a wrapper around
+// pango_layout_iter_get_line_extents().
+func (v *LayoutIter) GetLineExtents() (ink, logical Rectangle) {
+	var cink, clogical C.PangoRectangle
+	C.pango_layout_iter_get_line_extents(v.native(), &cink, &clogical)
+	return wrapRectangle(&cink), wrapRectangle(&clogical)
+}
+
+// GetLineYrange is a wrapper around pango_layout_iter_get_line_yrange().
+// Unlike the logical extents of the line, the range includes half of
+// the layout's spacing above and below the line.
+func (v *LayoutIter) GetLineYrange() (y0, y1 int) {
+	var cy0, cy1 C.int
+	C.pango_layout_iter_get_line_yrange(v.native(), &cy0, &cy1)
+	return int(cy0), int(cy1)
+}
+
+// GetLayoutExtents is a wrapper around
+// pango_layout_iter_get_layout_extents().
+func (v *LayoutIter) GetLayoutExtents() (ink, logical Rectangle) {
+	var cink, clogical C.PangoRectangle
+	C.pango_layout_iter_get_layout_extents(v.native(), &cink, &clogical)
+	return wrapRectangle(&cink), wrapRectangle(&clogical)
+}
@@ -19,6 +19,7 @@ package pango
 
 // #cgo pkg-config: pango
 // #include <pango/pango.h>
+// #include "pango.go.h"
 import "C"
 import (
 	"github.com/conformal/gotk3/glib"
@@ -28,16 +29,115 @@ import (
 func init() {
 	tm := []glib.TypeMarshaler{
 		// Enums
+		{glib.Type(C.pango_alignment_get_type()), marshalAlignment},
+		{glib.Type(C.pango_attr_type_get_type()), marshalAttrType},
 		{glib.Type(C.pango_ellipsize_mode_get_type()), marshalEllipsizeMode},
+		{glib.Type(C.pango_font_mask_get_type()), marshalFontMask},
+		{glib.Type(C.pango_gravity_get_type()), marshalGravity},
+		{glib.Type(C.pango_gravity_hint_get_type()), marshalGravityHint},
+		{glib.Type(C.pango_stretch_get_type()), marshalStretch},
+		{glib.Type(C.pango_style_get_type()), marshalStyle},
+		{glib.Type(C.pango_tab_align_get_type()), marshalTabAlign},
+		{glib.Type(C.pango_underline_get_type()), marshalUnderline},
+		{glib.Type(C.pango_variant_get_type()), marshalVariant},
+		{glib.Type(C.pango_weight_get_type()), marshalWeight},
 		{glib.Type(C.pango_wrap_mode_get_type()), marshalWrapMode},
+
+		// Objects/Interfaces
+		{glib.Type(C.pango_context_get_type()), marshalContext},
+		{glib.Type(C.pango_font_get_type()), marshalFont},
+		{glib.Type(C.pango_font_face_get_type()), marshalFontFace},
+		{glib.Type(C.pango_font_family_get_type()), marshalFontFamily},
+		{glib.Type(C.pango_font_map_get_type()), marshalFontMap},
+		{glib.Type(C.pango_layout_get_type()), marshalLayout},
+
+		// Boxed
+		{glib.Type(C.pango_attr_list_get_type()), marshalAttrList},
+		{glib.Type(C.pango_font_description_get_type()), marshalFontDescription},
+		{glib.Type(C.pango_font_metrics_get_type()), marshalFontMetrics},
+		{glib.Type(C.pango_glyph_item_get_type()), marshalGlyphItem},
+		{glib.Type(C.pango_glyph_string_get_type()), marshalGlyphString},
+		{glib.Type(C.pango_layout_iter_get_type()), marshalLayoutIter},
+		{glib.Type(C.pango_layout_line_get_type()), marshalLayoutLine},
+		{glib.Type(C.pango_tab_array_get_type()), marshalTabArray},
 	}
 	glib.RegisterGValueMarshalers(tm)
 }
 
+/*
+ * Type conversions
+ */
+
+func gbool(b bool) C.gboolean {
+	if b {
+		return C.gboolean(1)
+	}
+	return C.gboolean(0)
+}
+
+func gobool(b C.gboolean) bool {
+	if b != 0 {
+		return true
+	}
+	return false
+}
+
 /*
  * Constants
  */
 
+// SCALE is a representation of Pango's PANGO_SCALE, the number of Pango
+// units in one device unit.
+const SCALE = C.PANGO_SCALE
+
+// Alignment is a representation of Pango's PangoAlignment.
+type Alignment int
+
+const (
+	ALIGN_LEFT   Alignment = C.PANGO_ALIGN_LEFT
+	ALIGN_CENTER Alignment = C.PANGO_ALIGN_CENTER
+	ALIGN_RIGHT  Alignment = C.PANGO_ALIGN_RIGHT
+)
+
+func marshalAlignment(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return Alignment(c), nil
+}
+
+// AttrType is a representation of Pango's PangoAttrType.
+type AttrType int
+
+const (
+	ATTR_INVALID             AttrType = C.PANGO_ATTR_INVALID
+	ATTR_LANGUAGE            AttrType = C.PANGO_ATTR_LANGUAGE
+	ATTR_FAMILY              AttrType = C.PANGO_ATTR_FAMILY
+	ATTR_STYLE               AttrType = C.PANGO_ATTR_STYLE
+	ATTR_WEIGHT              AttrType = C.PANGO_ATTR_WEIGHT
+	ATTR_VARIANT             AttrType = C.PANGO_ATTR_VARIANT
+	ATTR_STRETCH             AttrType = C.PANGO_ATTR_STRETCH
+	ATTR_SIZE                AttrType = C.PANGO_ATTR_SIZE
+	ATTR_FONT_DESC           AttrType = C.PANGO_ATTR_FONT_DESC
+	ATTR_FOREGROUND          AttrType = C.PANGO_ATTR_FOREGROUND
+	ATTR_BACKGROUND          AttrType = C.PANGO_ATTR_BACKGROUND
+	ATTR_UNDERLINE           AttrType = C.PANGO_ATTR_UNDERLINE
+	ATTR_STRIKETHROUGH       AttrType = C.PANGO_ATTR_STRIKETHROUGH
+	ATTR_RISE                AttrType = C.PANGO_ATTR_RISE
+	ATTR_SHAPE               AttrType = C.PANGO_ATTR_SHAPE
+	ATTR_SCALE               AttrType = C.PANGO_ATTR_SCALE
+	ATTR_FALLBACK            AttrType = C.PANGO_ATTR_FALLBACK
+	ATTR_LETTER_SPACING      AttrType = C.PANGO_ATTR_LETTER_SPACING
+	ATTR_UNDERLINE_COLOR     AttrType = C.PANGO_ATTR_UNDERLINE_COLOR
+	ATTR_STRIKETHROUGH_COLOR AttrType = C.PANGO_ATTR_STRIKETHROUGH_COLOR
+	ATTR_ABSOLUTE_SIZE       AttrType = C.PANGO_ATTR_ABSOLUTE_SIZE
+	ATTR_GRAVITY             AttrType = C.PANGO_ATTR_GRAVITY
+	ATTR_GRAVITY_HINT        AttrType = C.PANGO_ATTR_GRAVITY_HINT
+)
+
+func marshalAttrType(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return AttrType(c), nil
+}
+
 // EllipsizeMode is a representation of Pango's PangoEllipsizeMode.
 type EllipsizeMode int
 
@@ -53,6 +153,155 @@ func marshalEllipsizeMode(p uintptr) (interface{}, error) {
 	return EllipsizeMode(c), nil
 }
 
+// FontMask is a representation of Pango's PangoFontMask.
+type FontMask int
+
+const (
+	FONT_MASK_FAMILY  FontMask = C.PANGO_FONT_MASK_FAMILY
+	FONT_MASK_STYLE   FontMask = C.PANGO_FONT_MASK_STYLE
+	FONT_MASK_VARIANT FontMask = C.PANGO_FONT_MASK_VARIANT
+	FONT_MASK_WEIGHT  FontMask = C.PANGO_FONT_MASK_WEIGHT
+	FONT_MASK_STRETCH FontMask = C.PANGO_FONT_MASK_STRETCH
+	FONT_MASK_SIZE    FontMask = C.PANGO_FONT_MASK_SIZE
+	FONT_MASK_GRAVITY FontMask = C.PANGO_FONT_MASK_GRAVITY
+)
+
+func marshalFontMask(p uintptr) (interface{}, error) {
+	c := C.g_value_get_flags((*C.GValue)(unsafe.Pointer(p)))
+	return FontMask(c), nil
+}
+
+// Gravity is a representation of Pango's PangoGravity, the direction in
+// which the base of glyphs points.
+type Gravity int
+
+const (
+	GRAVITY_SOUTH Gravity = C.PANGO_GRAVITY_SOUTH
+	GRAVITY_EAST  Gravity = C.PANGO_GRAVITY_EAST
+	GRAVITY_NORTH Gravity = C.PANGO_GRAVITY_NORTH
+	GRAVITY_WEST  Gravity = C.PANGO_GRAVITY_WEST
+	GRAVITY_AUTO  Gravity = C.PANGO_GRAVITY_AUTO
+)
+
+func marshalGravity(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return Gravity(c), nil
+}
+
+// GravityHint is a representation of Pango's PangoGravityHint.
+type GravityHint int
+
+const (
+	GRAVITY_HINT_NATURAL GravityHint = C.PANGO_GRAVITY_HINT_NATURAL
+	GRAVITY_HINT_STRONG  GravityHint = C.PANGO_GRAVITY_HINT_STRONG
+	GRAVITY_HINT_LINE    GravityHint = C.PANGO_GRAVITY_HINT_LINE
+)
+
+func marshalGravityHint(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return GravityHint(c), nil
+}
+
+// Stretch is a representation of Pango's PangoStretch.
+type Stretch int
+
+const (
+	STRETCH_ULTRA_CONDENSED Stretch = C.PANGO_STRETCH_ULTRA_CONDENSED
+	STRETCH_EXTRA_CONDENSED Stretch = C.PANGO_STRETCH_EXTRA_CONDENSED
+	STRETCH_CONDENSED       Stretch = C.PANGO_STRETCH_CONDENSED
+	STRETCH_SEMI_CONDENSED  Stretch = C.PANGO_STRETCH_SEMI_CONDENSED
+	STRETCH_NORMAL          Stretch = C.PANGO_STRETCH_NORMAL
+	STRETCH_SEMI_EXPANDED   Stretch = C.PANGO_STRETCH_SEMI_EXPANDED
+	STRETCH_EXPANDED        Stretch = C.PANGO_STRETCH_EXPANDED
+	STRETCH_EXTRA_EXPANDED  Stretch = C.PANGO_STRETCH_EXTRA_EXPANDED
+	STRETCH_ULTRA_EXPANDED  Stretch = C.PANGO_STRETCH_ULTRA_EXPANDED
+)
+
+func marshalStretch(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return Stretch(c), nil
+}
+
+// Style is a representation of Pango's PangoStyle.
+type Style int
+
+const (
+	STYLE_NORMAL  Style = C.PANGO_STYLE_NORMAL
+	STYLE_OBLIQUE Style = C.PANGO_STYLE_OBLIQUE
+	STYLE_ITALIC  Style = C.PANGO_STYLE_ITALIC
+)
+
+func marshalStyle(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return Style(c), nil
+}
+
+// TabAlign is a representation of Pango's PangoTabAlign.
+type TabAlign int
+
+const (
+	TAB_LEFT TabAlign = C.PANGO_TAB_LEFT
+)
+
+func marshalTabAlign(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return TabAlign(c), nil
+}
+
+// Underline is a representation of Pango's PangoUnderline.
+type Underline int
+
+const (
+	UNDERLINE_NONE   Underline = C.PANGO_UNDERLINE_NONE
+	UNDERLINE_SINGLE Underline = C.PANGO_UNDERLINE_SINGLE
+	UNDERLINE_DOUBLE Underline = C.PANGO_UNDERLINE_DOUBLE
+	UNDERLINE_LOW    Underline = C.PANGO_UNDERLINE_LOW
+	UNDERLINE_ERROR  Underline = C.PANGO_UNDERLINE_ERROR
+)
+
+func marshalUnderline(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return Underline(c), nil
+}
+
+// Variant is a representation of Pango's PangoVariant.
+type Variant int
+
+const (
+	VARIANT_NORMAL     Variant = C.PANGO_VARIANT_NORMAL
+	VARIANT_SMALL_CAPS Variant = C.PANGO_VARIANT_SMALL_CAPS
+)
+
+func marshalVariant(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return Variant(c), nil
+}
+
+// Weight is a representation of Pango's PangoWeight.  Weights are
+// numeric, and any value between WEIGHT_THIN and WEIGHT_ULTRAHEAVY may
+// be used.
+type Weight int
+
+const (
+	WEIGHT_THIN       Weight = C.PANGO_WEIGHT_THIN
+	WEIGHT_ULTRALIGHT Weight = C.PANGO_WEIGHT_ULTRALIGHT
+	WEIGHT_LIGHT      Weight = C.PANGO_WEIGHT_LIGHT
+	// WEIGHT_SEMILIGHT  Weight = C.PANGO_WEIGHT_SEMILIGHT (since 1.36.7)
+	WEIGHT_BOOK       Weight = C.PANGO_WEIGHT_BOOK
+	WEIGHT_NORMAL     Weight = C.PANGO_WEIGHT_NORMAL
+	WEIGHT_MEDIUM     Weight = C.PANGO_WEIGHT_MEDIUM
+	WEIGHT_SEMIBOLD   Weight = C.PANGO_WEIGHT_SEMIBOLD
+	WEIGHT_BOLD       Weight = C.PANGO_WEIGHT_BOLD
+	WEIGHT_ULTRABOLD  Weight = C.PANGO_WEIGHT_ULTRABOLD
+	WEIGHT_HEAVY      Weight = C.PANGO_WEIGHT_HEAVY
+	WEIGHT_ULTRAHEAVY Weight = C.PANGO_WEIGHT_ULTRAHEAVY
+)
+
+func marshalWeight(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return Weight(c), nil
+}
+
 // WrapMode is a representation of Pango's PangoWrapMode.
 type WrapMode int
 
@@ -66,3 +315,54 @@ func marshalWrapMode(p uintptr) (interface{}, error) {
 	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
 	return WrapMode(c), nil
 }
+
+/*
+ * Units
+ */
+
+// UnitsFromDouble is a wrapper around pango_units_from_double().  It
+// converts a value in device units to Pango units, rounding to the
+// nearest unit.
+func UnitsFromDouble(d float64) int {
+	c := C.pango_units_from_double(C.double(d))
+	return int(c)
+}
+
+// UnitsToDouble is a wrapper around pango_units_to_double().  It
+// converts a value in Pango units to device units.
+func UnitsToDouble(i int) float64 {
+	c := C.pango_units_to_double(C.int(i))
+	return float64(c)
+}
+
+// Pixels is a representation of Pango's PANGO_PIXELS macro.  It converts
+// a value in Pango units to device units, rounding to the nearest pixel.
+func Pixels(d int) int {
+	return (d + 512) >> 10
+}
+
+// PixelsFloor is a representation of Pango's PANGO_PIXELS_FLOOR macro.
+func PixelsFloor(d int) int {
+	return d >> 10
+}
+
+// PixelsCeil is a representation of Pango's PANGO_PIXELS_CEIL macro.
+func PixelsCeil(d int) int {
+	return (d + 1023) >> 10
+}
+
+/*
+ * PangoRectangle
+ */
+
+// Rectangle is a representation of Pango's PangoRectangle.  Depending on
+// the function returning it, its values are in Pango units or pixels.
+type Rectangle struct {
+	X, Y          int
+	Width, Height int
+}
+
+func wrapRectangle(rect *C.PangoRectangle) Rectangle {
+	return Rectangle{int(rect.x), int(rect.y), int(rect.width),
+		int(rect.height)}
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+ *
+ * This file originated from: http://opensource.conformal.com/
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+#ifndef __PANGO_GO_H__
+#define __PANGO_GO_H__
+
+#include <stdlib.h>
+
+/* Type Casting */
+static PangoContext *
+toPangoContext(void *p)
+{
+	return (PANGO_CONTEXT(p));
+}
+
+static PangoFont *
+toPangoFont(void *p)
+{
+	return (PANGO_FONT(p));
+}
+
+static PangoFontFace *
+toPangoFontFace(void *p)
+{
+	return (PANGO_FONT_FACE(p));
+}
+
+static PangoFontFamily *
+toPangoFontFamily(void *p)
+{
+	return (PANGO_FONT_FAMILY(p));
+}
+
+static PangoFontMap *
+toPangoFontMap(void *p)
+{
+	return (PANGO_FONT_MAP(p));
+}
+
+static PangoLayout *
+toPangoLayout(void *p)
+{
+	return (PANGO_LAYOUT(p));
+}
+
+#endif
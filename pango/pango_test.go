@@ -0,0 +1,504 @@
+package pango_test
+
+import (
+	"testing"
+
+	"github.com/conformal/gotk3/cairo"
+	"github.com/conformal/gotk3/pango"
+)
+
+func TestFontDescription(t *testing.T) {
+	desc := pango.FontDescriptionFromString("Serif Bold Italic 12")
+	if family := desc.GetFamily(); family != "Serif" {
+		t.Errorf("GetFamily returned %q", family)
+	}
+	if weight := desc.GetWeight(); weight != pango.WEIGHT_BOLD {
+		t.Errorf("GetWeight returned %d", weight)
+	}
+	if style := desc.GetStyle(); style != pango.STYLE_ITALIC {
+		t.Errorf("GetStyle returned %d", style)
+	}
+	if size := desc.GetSize(); size != 12*pango.SCALE {
+		t.Errorf("GetSize returned %d", size)
+	}
+	if desc.GetSizeIsAbsolute() {
+		t.Error("Point size reported as absolute")
+	}
+	if s := desc.ToString(); s != "Serif Bold Italic 12" {
+		t.Errorf("ToString returned %q", s)
+	}
+
+	other := pango.FontDescriptionNew()
+	if fields := other.GetSetFields(); fields != 0 {
+		t.Errorf("New description has set fields %#x", fields)
+	}
+	other.SetFamily("Serif")
+	other.SetWeight(pango.WEIGHT_BOLD)
+	other.SetStyle(pango.STYLE_ITALIC)
+	other.SetSize(12 * pango.SCALE)
+	if !desc.Equal(other) || desc.Hash() != other.Hash() {
+		t.Error("Equivalent descriptions compare unequal")
+	}
+
+	other.UnsetFields(pango.FONT_MASK_WEIGHT)
+	if other.GetSetFields()&pango.FONT_MASK_WEIGHT != 0 {
+		t.Error("UnsetFields did not unset weight")
+	}
+	other.Merge(desc, false)
+	if !desc.Equal(other) {
+		t.Error("Merge did not restore weight")
+	}
+
+	cp := desc.Copy()
+	cp.SetStretch(pango.STRETCH_CONDENSED)
+	cp.SetVariant(pango.VARIANT_SMALL_CAPS)
+	if desc.Equal(cp) {
+		t.Error("Modifying copy changed original")
+	}
+	if cp.GetStretch() != pango.STRETCH_CONDENSED ||
+		cp.GetVariant() != pango.VARIANT_SMALL_CAPS {
+		t.Error("Stretch or variant not set")
+	}
+}
+
+func TestTabArrayNew(t *testing.T) {
+	tabs := pango.TabArrayNew(2, true)
+	if n := tabs.GetSize(); n != 2 {
+		t.Errorf("GetSize returned %d", n)
+	}
+	if !tabs.GetPositionsInPixels() {
+		t.Error("Positions not in pixels")
+	}
+	if pango.TabArrayNew(1, false).GetPositionsInPixels() {
+		t.Error("Positions in Pango units reported as pixels")
+	}
+}
+
+func newCairoContext(t *testing.T, width, height int) (*cairo.ImageSurface, *cairo.Context) {
+	surface, err := cairo.ImageSurfaceCreate(cairo.FORMAT_ARGB32, width, height)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cr, err := cairo.Create(surface.Surface)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return surface, cr
+}
+
+func TestLayout(t *testing.T) {
+	_, cr := newCairoContext(t, 1, 1)
+	layout := pango.CairoCreateLayout(cr)
+	layout.SetFontDescription(pango.FontDescriptionFromString("Sans 12"))
+
+	layout.SetText("hello world")
+	if text := layout.GetText(); text != "hello world" {
+		t.Errorf("GetText returned %q", text)
+	}
+	if n := layout.GetLineCount(); n != 1 {
+		t.Errorf("GetLineCount returned %d for one line", n)
+	}
+	width, height := layout.GetPixelSize()
+	if width <= 0 || height <= 0 {
+		t.Fatalf("GetPixelSize returned %dx%d", width, height)
+	}
+	_, logical := layout.GetExtents()
+	if d := logical.Width - width*pango.SCALE; d < -pango.SCALE || d > pango.SCALE {
+		t.Errorf("Logical width %d does not match pixel width %d",
+			logical.Width, width)
+	}
+
+	// Wrap at a width narrower than the text.
+	layout.SetWidth(width * pango.SCALE / 2)
+	layout.SetWrap(pango.WRAP_WORD)
+	if n := layout.GetLineCount(); n != 2 {
+		t.Errorf("GetLineCount returned %d after wrapping", n)
+	}
+	if !layout.IsWrapped() {
+		t.Error("Layout not reported as wrapped")
+	}
+	line, _ := layout.IndexToLineX(len("hello "), false)
+	if line != 1 {
+		t.Errorf("Second word on line %d", line)
+	}
+
+	// The position of a character maps back to its index.
+	pos := layout.IndexToPos(len("hello w"))
+	index, trailing, inside := layout.XYToIndex(pos.X+1, pos.Y+1)
+	if index != len("hello w") || trailing != 0 || !inside {
+		t.Errorf("XYToIndex returned %d, %d, %v", index, trailing, inside)
+	}
+
+	layout.SetWidth(-1)
+	layout.SetMarkup("<b>bold</b> text")
+	if text := layout.GetText(); text != "bold text" {
+		t.Errorf("GetText returned %q after SetMarkup", text)
+	}
+
+	layout.SetAlignment(pango.ALIGN_CENTER)
+	layout.SetJustify(true)
+	layout.SetIndent(2 * pango.SCALE)
+	layout.SetSpacing(3 * pango.SCALE)
+	layout.SetEllipsize(pango.ELLIPSIZE_END)
+	if layout.GetAlignment() != pango.ALIGN_CENTER || !layout.GetJustify() ||
+		layout.GetIndent() != 2*pango.SCALE ||
+		layout.GetSpacing() != 3*pango.SCALE ||
+		layout.GetEllipsize() != pango.ELLIPSIZE_END {
+		t.Error("Layout properties not set")
+	}
+}
+
+func TestCairoShowLayout(t *testing.T) {
+	surface, cr := newCairoContext(t, 100, 40)
+	layout := pango.CairoCreateLayout(cr)
+	layout.SetFontDescription(pango.FontDescriptionFromString("Sans 16"))
+	layout.SetText("Go")
+
+	cr.SetSourceRGB(0, 0, 0)
+	cr.MoveTo(5, 5)
+	pango.CairoShowLayout(cr, layout)
+	surface.Flush()
+
+	img, err := surface.RGBA()
+	if err != nil {
+		t.Fatal(err)
+	}
+	drawn := false
+	for i := 3; i < len(img.Pix); i += 4 {
+		if img.Pix[i] != 0 {
+			drawn = true
+			break
+		}
+	}
+	if !drawn {
+		t.Error("CairoShowLayout drew nothing")
+	}
+
+	cr.NewPath()
+	pango.CairoLayoutPath(cr, layout)
+	if !cr.HasCurrentPoint() {
+		t.Error("CairoLayoutPath added no path")
+	}
+}
+
+func TestCairoResolution(t *testing.T) {
+	fontMap := pango.CairoFontMapNew()
+	fontMap.SetResolution(144)
+	if dpi := fontMap.GetResolution(); dpi != 144 {
+		t.Errorf("GetResolution returned %v", dpi)
+	}
+
+	_, cr := newCairoContext(t, 1, 1)
+	context := pango.CairoCreateContext(cr)
+	if dpi := pango.CairoContextGetResolution(context); dpi >= 0 {
+		t.Errorf("New context has resolution %v", dpi)
+	}
+	layout := pango.LayoutNew(context)
+	layout.SetFontDescription(pango.FontDescriptionFromString("Sans 12"))
+	layout.SetText("x")
+	pango.CairoContextSetResolution(context, 96)
+	layout.ContextChanged()
+	_, height96 := layout.GetPixelSize()
+	pango.CairoContextSetResolution(context, 192)
+	layout.ContextChanged()
+	_, height192 := layout.GetPixelSize()
+	if height192 < height96*3/2 {
+		t.Errorf("Height %d at 192 dpi not larger than %d at 96 dpi",
+			height192, height96)
+	}
+}
+
+func TestAttrList(t *testing.T) {
+	list := pango.AttrListNew()
+
+	bold := pango.AttrWeightNew(pango.WEIGHT_BOLD)
+	bold.SetStartIndex(0)
+	bold.SetEndIndex(4)
+	list.Insert(bold)
+
+	red := pango.AttrForegroundNew(0xffff, 0, 0)
+	red.SetStartIndex(2)
+	red.SetEndIndex(pango.ATTR_INDEX_TO_TEXT_END)
+	list.Insert(red)
+
+	list.Insert(pango.AttrScaleNew(1.5))
+	list.Insert(pango.AttrUnderlineNew(pango.UNDERLINE_DOUBLE))
+
+	if bold.GetType() != pango.ATTR_WEIGHT || bold.GetEndIndex() != 4 {
+		t.Error("Weight attribute has wrong type or range")
+	}
+	if _, ok := bold.GetColor(); ok {
+		t.Error("GetColor succeeded for weight attribute")
+	}
+
+	type segment struct {
+		start, end int
+		weight     bool
+		color      bool
+	}
+	var segments []segment
+	iter := list.GetIterator()
+	for ok := true; ok; ok = iter.Next() {
+		// Older Pango releases end iteration with an empty segment.
+		start, end := iter.Range()
+		if start >= end {
+			continue
+		}
+		var s segment
+		s.start, s.end = start, end
+		if a := iter.Get(pango.ATTR_WEIGHT); a != nil {
+			if w, ok := a.GetInt(); !ok || pango.Weight(w) != pango.WEIGHT_BOLD {
+				t.Errorf("Weight attribute has value %d", w)
+			}
+			s.weight = true
+		}
+		if a := iter.Get(pango.ATTR_FOREGROUND); a != nil {
+			c, ok := a.GetColor()
+			if !ok || c != (pango.Color{Red: 0xffff}) {
+				t.Errorf("Foreground attribute has value %v", c)
+			}
+			s.color = true
+		}
+		if n := len(iter.GetAttrs()); n < 2 {
+			t.Errorf("Segment %d-%d has %d attributes", start, end, n)
+		}
+		segments = append(segments, s)
+	}
+	if len(segments) != 3 {
+		t.Fatalf("Iterated %d segments, expected 3", len(segments))
+	}
+	if s := segments[0]; s.start != 0 || s.end != 2 || !s.weight || s.color {
+		t.Errorf("First segment is %+v", s)
+	}
+	if s := segments[1]; s.start != 2 || s.end != 4 || !s.weight || !s.color {
+		t.Errorf("Second segment is %+v", s)
+	}
+	if s := segments[2]; s.start != 4 || s.weight || !s.color {
+		t.Errorf("Third segment is %+v", s)
+	}
+
+	scale := list.GetIterator().Get(pango.ATTR_SCALE)
+	if f, ok := scale.GetFloat(); !ok || f != 1.5 {
+		t.Errorf("Scale attribute has value %v", f)
+	}
+}
+
+func TestParseMarkup(t *testing.T) {
+	text, attrs, accel, err := pango.ParseMarkup(
+		"<span foreground=\"blue\">_Save</span> <i>all</i>", '_')
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text != "Save all" {
+		t.Errorf("ParseMarkup returned text %q", text)
+	}
+	if accel != 'S' {
+		t.Errorf("ParseMarkup returned accelerator %q", accel)
+	}
+	blue, err := pango.ColorParse("blue")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fg := attrs.GetIterator().Get(pango.ATTR_FOREGROUND)
+	if c, ok := fg.GetColor(); !ok || c != blue {
+		t.Errorf("Foreground attribute has value %v", c)
+	}
+
+	if _, _, _, err := pango.ParseMarkup("<b>unclosed", 0); err == nil {
+		t.Error("Expected error for invalid markup")
+	}
+	if _, err := pango.ColorParse("not a color"); err == nil {
+		t.Error("Expected error for invalid color")
+	}
+}
+
+func TestFontFamilies(t *testing.T) {
+	families := pango.CairoFontMapGetDefault().ListFamilies()
+	if len(families) == 0 {
+		t.Skip("No fonts installed")
+	}
+	for _, family := range families {
+		if family.GetName() == "" {
+			t.Error("Family has empty name")
+		}
+		faces := family.ListFaces()
+		if len(faces) == 0 {
+			t.Errorf("Family %q has no faces", family.GetName())
+		}
+		for _, face := range faces {
+			desc := face.Describe()
+			if desc.GetFamily() != family.GetName() {
+				t.Errorf("Face %q of %q describes family %q",
+					face.GetFaceName(), family.GetName(),
+					desc.GetFamily())
+			}
+		}
+	}
+}
+
+func TestFontMetrics(t *testing.T) {
+	_, cr := newCairoContext(t, 1, 1)
+	context := pango.CairoCreateContext(cr)
+	desc := pango.FontDescriptionFromString("Monospace 12")
+
+	font := context.LoadFont(desc)
+	if font == nil {
+		t.Fatal("LoadFont returned nil")
+	}
+	if font.GetFontMap() == nil {
+		t.Error("Font has no font map")
+	}
+	if size := font.Describe().GetSize(); size != 12*pango.SCALE {
+		t.Errorf("Loaded font has size %d", size)
+	}
+
+	metrics := context.GetMetrics(desc)
+	if metrics.GetAscent() <= 0 || metrics.GetDescent() <= 0 {
+		t.Errorf("Metrics have ascent %d and descent %d",
+			metrics.GetAscent(), metrics.GetDescent())
+	}
+	if metrics.GetApproximateCharWidth() <= 0 ||
+		metrics.GetApproximateDigitWidth() <= 0 {
+		t.Error("Metrics have no approximate character width")
+	}
+	if metrics.GetUnderlineThickness() <= 0 {
+		t.Error("Metrics have no underline thickness")
+	}
+	if m := font.GetMetrics(); m.GetAscent() <= 0 {
+		t.Errorf("Font metrics have ascent %d", m.GetAscent())
+	}
+}
+
+func TestLayoutIter(t *testing.T) {
+	_, cr := newCairoContext(t, 1, 1)
+	layout := pango.CairoCreateLayout(cr)
+	layout.SetFontDescription(pango.FontDescriptionFromString("Sans 12"))
+	layout.SetText("first line\nsecond")
+
+	lines := layout.GetLines()
+	if len(lines) != 2 {
+		t.Fatalf("GetLines returned %d lines", len(lines))
+	}
+	second := layout.GetLine(1)
+	if second.GetStartIndex() != len("first line\n") ||
+		second.GetLength() != len("second") || !second.IsParagraphStart() {
+		t.Errorf("Second line starts at %d with length %d",
+			second.GetStartIndex(), second.GetLength())
+	}
+	if layout.GetLine(2) != nil {
+		t.Error("GetLine returned line out of range")
+	}
+
+	// Positions on a line map back to their indexes.
+	first := lines[0]
+	x := first.IndexToX(len("first "), false)
+	index, trailing, inside := first.XToIndex(x + 1)
+	if index != len("first ") || trailing != 0 || !inside {
+		t.Errorf("XToIndex returned %d, %d, %v", index, trailing, inside)
+	}
+
+	iter := layout.GetIter()
+	nlines := 1
+	for iter.NextLine() {
+		nlines++
+	}
+	if nlines != 2 || !iter.AtLastLine() {
+		t.Errorf("Iterated %d lines", nlines)
+	}
+
+	iter = layout.GetIter()
+	baseline := iter.GetBaseline()
+	_, logical := iter.GetLineExtents()
+	if baseline <= logical.Y || baseline >= logical.Y+logical.Height {
+		t.Errorf("Baseline %d outside of line %+v", baseline, logical)
+	}
+
+	run := iter.GetRun()
+	if run == nil {
+		t.Fatal("First run is nil")
+	}
+	if run.GetOffset() != 0 || run.GetLength() != len("first line") {
+		t.Errorf("First run covers %d bytes from %d", run.GetLength(),
+			run.GetOffset())
+	}
+	glyphs := run.GetGlyphs()
+	// Ligatures may shape several characters as one glyph.
+	if n := glyphs.GetNumGlyphs(); n == 0 || n > len("first line") ||
+		len(glyphs.GetGlyphs()) != n || len(glyphs.GetLogClusters()) != n {
+		t.Errorf("First run has %d glyphs", n)
+	}
+	_, runLogical := iter.GetRunExtents()
+	if w := glyphs.GetWidth(); w != runLogical.Width {
+		t.Errorf("Glyph width %d differs from run width %d", w,
+			runLogical.Width)
+	}
+	if run.GetFont() == nil {
+		t.Error("Run has no font")
+	}
+
+	nchars := 0
+	for {
+		if iter.GetLine().GetStartIndex() != 0 {
+			break
+		}
+		nchars++
+		if !iter.NextChar() {
+			break
+		}
+	}
+	if nchars < len("first line") {
+		t.Errorf("Iterated %d characters on first line", nchars)
+	}
+}
+
+func TestTabArray(t *testing.T) {
+	tabs := pango.TabArrayNewWithPositions(true, 40, 120)
+	if n := tabs.GetSize(); n != 2 {
+		t.Errorf("GetSize returned %d", n)
+	}
+	if !tabs.GetPositionsInPixels() {
+		t.Error("Positions not in pixels")
+	}
+	if align, location := tabs.GetTab(1); align != pango.TAB_LEFT || location != 120 {
+		t.Errorf("GetTab returned %d, %d", align, location)
+	}
+	cp := tabs.Copy()
+	cp.SetTab(2, pango.TAB_LEFT, 200)
+	if tabs.GetSize() != 2 || cp.GetSize() != 3 {
+		t.Error("Setting tab of copy changed original")
+	}
+
+	_, cr := newCairoContext(t, 1, 1)
+	layout := pango.CairoCreateLayout(cr)
+	if layout.GetTabs() != nil {
+		t.Error("New layout has tabs")
+	}
+	layout.SetText("a\tb")
+	layout.SetTabs(tabs)
+	if _, location := layout.GetTabs().GetTab(0); location != 40 {
+		t.Errorf("Layout tab at %d", location)
+	}
+	x := layout.IndexToPos(len("a\t")).X
+	if pango.Pixels(x) != 40 {
+		t.Errorf("Tabbed text at %d pixels", pango.Pixels(x))
+	}
+}
+
+func TestUnits(t *testing.T) {
+	if u := pango.UnitsFromDouble(2.5); u != 5*pango.SCALE/2 {
+		t.Errorf("UnitsFromDouble returned %d", u)
+	}
+	if d := pango.UnitsToDouble(3 * pango.SCALE); d != 3 {
+		t.Errorf("UnitsToDouble returned %v", d)
+	}
+	half := pango.SCALE / 2
+	if p := pango.Pixels(2*pango.SCALE + half - 1); p != 2 {
+		t.Errorf("Pixels rounded to %d", p)
+	}
+	if p := pango.PixelsFloor(2*pango.SCALE + half); p != 2 {
+		t.Errorf("PixelsFloor returned %d", p)
+	}
+	if p := pango.PixelsCeil(2*pango.SCALE + 1); p != 3 {
+		t.Errorf("PixelsCeil returned %d", p)
+	}
+}
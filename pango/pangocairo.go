@@ -0,0 +1,190 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package pango
+
+// #cgo pkg-config: pango pangocairo
+// #include <pango/pango.h>
+// #include <pango/pangocairo.h>
+// #include "pango.go.h"
+//
+// static PangoCairoFontMap *
+// toPangoCairoFontMap(void *p)
+// {
+// 	return (PANGO_CAIRO_FONT_MAP(p));
+// }
+import "C"
+import (
+	"github.com/conformal/gotk3/cairo"
+	"github.com/conformal/gotk3/glib"
+	"runtime"
+	"unsafe"
+)
+
+// cairoContext returns the cairo_t underlying cr.
+func cairoContext(cr *cairo.Context) *C.cairo_t {
+	return (*C.cairo_t)(unsafe.Pointer(cr.Native()))
+}
+
+/*
+ * PangoCairoFontMap
+ */
+
+// CairoFontMap is a representation of PangoCairo's PangoCairoFontMap
+// GInterface, implemented by font maps which render with Cairo.
+type CairoFontMap struct {
+	*FontMap
+}
+
+// nativeCairo returns a pointer to the underlying PangoCairoFontMap.
+func (v *CairoFontMap) nativeCairo() *C.PangoCairoFontMap {
+	if v == nil || v.FontMap == nil || v.GObject == nil {
+		return nil
+	}
+	p := unsafe.Pointer(v.GObject)
+	return C.toPangoCairoFontMap(p)
+}
+
+// CairoFontMapNew is a wrapper around pango_cairo_font_map_new().
+func CairoFontMapNew() *CairoFontMap {
+	c := C.pango_cairo_font_map_new()
+	obj := &glib.Object{glib.ToGObject(unsafe.Pointer(c))}
+	runtime.SetFinalizer(obj, (*glib.Object).Unref)
+	return &CairoFontMap{wrapFontMap(obj)}
+}
+
+// CairoFontMapGetDefault is a wrapper around
+// pango_cairo_font_map_get_default().  The default font map is used by
+// CairoCreateContext and CairoCreateLayout.
+func CairoFontMapGetDefault() *CairoFontMap {
+	c := C.pango_cairo_font_map_get_default()
+	return &CairoFontMap{refFontMap(c)}
+}
+
+// CairoFontMapSetDefault is a wrapper around
+// pango_cairo_font_map_set_default().  Passing nil restores the initial
+// default font map.
+func CairoFontMapSetDefault(fontMap *CairoFontMap) {
+	C.pango_cairo_font_map_set_default(fontMap.nativeCairo())
+}
+
+// SetResolution is a wrapper around
+// pango_cairo_font_map_set_resolution().  The resolution, in dots per
+// inch, converts point sizes to device units for contexts created after
+// the change.
+func (v *CairoFontMap) SetResolution(dpi float64) {
+	C.pango_cairo_font_map_set_resolution(v.nativeCairo(), C.double(dpi))
+}
+
+// GetResolution is a wrapper around
+// pango_cairo_font_map_get_resolution().
+func (v *CairoFontMap) GetResolution() float64 {
+	c := C.pango_cairo_font_map_get_resolution(v.nativeCairo())
+	return float64(c)
+}
+
+/*
+ * PangoCairo contexts
+ */
+
+// CairoCreateContext is a wrapper around pango_cairo_create_context().
+// The context uses the default CairoFontMap and is set up to match the
+// transformation and target surface of cr.
+func CairoCreateContext(cr *cairo.Context) *Context {
+	c := C.pango_cairo_create_context(cairoContext(cr))
+	return takeContext(c)
+}
+
+// CairoUpdateContext is a wrapper around pango_cairo_update_context().
+// It must be called when the transformation or target surface of cr
+// changes.
+func CairoUpdateContext(cr *cairo.Context, context *Context) {
+	C.pango_cairo_update_context(cairoContext(cr), context.native())
+}
+
+// CairoContextSetResolution is a wrapper around
+// pango_cairo_context_set_resolution().  A negative dpi unsets the
+// resolution, so the font map's resolution is used instead.
+func CairoContextSetResolution(context *Context, dpi float64) {
+	C.pango_cairo_context_set_resolution(context.native(), C.double(dpi))
+}
+
+// CairoContextGetResolution is a wrapper around
+// pango_cairo_context_get_resolution().  It returns a negative value if
+// no resolution has been set.
+func CairoContextGetResolution(context *Context) float64 {
+	c := C.pango_cairo_context_get_resolution(context.native())
+	return float64(c)
+}
+
+// CairoContextSetFontOptions is a wrapper around
+// pango_cairo_context_set_font_options().  Passing nil unsets the
+// options, so those of the target surface are used.
+func CairoContextSetFontOptions(context *Context, options *cairo.FontOptions) {
+	o := (*C.cairo_font_options_t)(unsafe.Pointer(options.Native()))
+	C.pango_cairo_context_set_font_options(context.native(), o)
+}
+
+/*
+ * PangoCairo layouts
+ */
+
+// CairoCreateLayout is a wrapper around pango_cairo_create_layout().  It
+// creates a layout with a new Context set up for drawing to cr.  Call
+// CairoUpdateLayout if the transformation or target surface of cr
+// changes before the layout is drawn.
+func CairoCreateLayout(cr *cairo.Context) *Layout {
+	c := C.pango_cairo_create_layout(cairoContext(cr))
+	return takeLayout(c)
+}
+
+// CairoUpdateLayout is a wrapper around pango_cairo_update_layout().
+func CairoUpdateLayout(cr *cairo.Context, layout *Layout) {
+	C.pango_cairo_update_layout(cairoContext(cr), layout.native())
+}
+
+// CairoShowLayout is a wrapper around pango_cairo_show_layout().  The
+// top-left corner of the layout is drawn at the current point of cr
+// using its current source.
+func CairoShowLayout(cr *cairo.Context, layout *Layout) {
+	C.pango_cairo_show_layout(cairoContext(cr), layout.native())
+}
+
+// CairoLayoutPath is a wrapper around pango_cairo_layout_path().  It adds
+// the outlines of the text in layout to the current path of cr, so they
+// may be stroked or filled.
+func CairoLayoutPath(cr *cairo.Context, layout *Layout) {
+	C.pango_cairo_layout_path(cairoContext(cr), layout.native())
+}
+
+// CairoShowLayoutLine is a wrapper around pango_cairo_show_layout_line().
+// The origin of the line's baseline is drawn at the current point of cr.
+func CairoShowLayoutLine(cr *cairo.Context, line *LayoutLine) {
+	C.pango_cairo_show_layout_line(cairoContext(cr), line.native())
+}
+
+// CairoLayoutLinePath is a wrapper around pango_cairo_layout_line_path().
+func CairoLayoutLinePath(cr *cairo.Context, line *LayoutLine) {
+	C.pango_cairo_layout_line_path(cairoContext(cr), line.native())
+}
+
+// CairoShowGlyphString is a wrapper around
+// pango_cairo_show_glyph_string().  The origin of the glyphs' baseline
+// is drawn at the current point of cr.
+func CairoShowGlyphString(cr *cairo.Context, font *Font, glyphs *GlyphString) {
+	C.pango_cairo_show_glyph_string(cairoContext(cr), font.native(),
+		glyphs.native())
+}
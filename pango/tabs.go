@@ -0,0 +1,136 @@
+// Copyright (c) 2013-2014 Conformal Systems <info@conformal.com>
+//
+// This file originated from: http://opensource.conformal.com/
+//
+// Permission to use, copy, modify, and distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+// ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+// ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+// OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package pango
+
+// #cgo pkg-config: pango
+// #include <pango/pango.h>
+import "C"
+import (
+	"runtime"
+	"unsafe"
+)
+
+/*
+ * PangoTabArray
+ */
+
+// TabArray is a representation of Pango's PangoTabArray, a list of tab
+// stops.  Tab positions are given either in pixels or in Pango units,
+// as chosen when the array is created.
+type TabArray struct {
+	tabArray *C.PangoTabArray
+}
+
+// native returns a pointer to the underlying PangoTabArray.
+func (v *TabArray) native() *C.PangoTabArray {
+	if v == nil {
+		return nil
+	}
+	return v.tabArray
+}
+
+// Native returns a pointer to the underlying PangoTabArray.
+func (v *TabArray) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalTabArray(p uintptr) (interface{}, error) {
+	c := C.g_value_get_boxed((*C.GValue)(unsafe.Pointer(p)))
+	return NewTabArray(uintptr(c), true), nil
+}
+
+// NewTabArray creates a new TabArray from a pointer to a C
+// PangoTabArray, for use by other packages.  Tab arrays are not
+// reference counted, so if needsCopy is true the returned value holds a
+// copy of the array; otherwise it takes ownership of p.  It returns nil
+// if p is 0.
+func NewTabArray(p uintptr, needsCopy bool) *TabArray {
+	if p == 0 {
+		return nil
+	}
+	c := (*C.PangoTabArray)(unsafe.Pointer(p))
+	if needsCopy {
+		c = C.pango_tab_array_copy(c)
+	}
+	t := &TabArray{c}
+	runtime.SetFinalizer(t, (*TabArray).free)
+	return t
+}
+
+// TabArrayNew is a wrapper around pango_tab_array_new().  All tab stops
+// are initially at position 0.
+func TabArrayNew(initialSize int, positionsInPixels bool) *TabArray {
+	c := C.pango_tab_array_new(C.gint(initialSize), gbool(positionsInPixels))
+	return NewTabArray(uintptr(unsafe.Pointer(c)), false)
+}
+
+// TabArrayNewWithPositions creates a TabArray of left-aligned tab stops
+// at the given positions, in the manner of
+// pango_tab_array_new_with_positions().
+func TabArrayNewWithPositions(positionsInPixels bool, positions ...int) *TabArray {
+	t := TabArrayNew(len(positions), positionsInPixels)
+	for i, location := range positions {
+		t.SetTab(i, TAB_LEFT, location)
+	}
+	return t
+}
+
+// free is a wrapper around pango_tab_array_free().
+func (v *TabArray) free() {
+	C.pango_tab_array_free(v.native())
+}
+
+// Copy is a wrapper around pango_tab_array_copy().
+func (v *TabArray) Copy() *TabArray {
+	c := C.pango_tab_array_copy(v.native())
+	return NewTabArray(uintptr(unsafe.Pointer(c)), false)
+}
+
+// GetSize is a wrapper around pango_tab_array_get_size().
+func (v *TabArray) GetSize() int {
+	c := C.pango_tab_array_get_size(v.native())
+	return int(c)
+}
+
+// Resize is a wrapper around pango_tab_array_resize().  New tab stops
+// are at position 0.
+func (v *TabArray) Resize(newSize int) {
+	C.pango_tab_array_resize(v.native(), C.gint(newSize))
+}
+
+// SetTab is a wrapper around pango_tab_array_set_tab().  The array is
+// resized if tabIndex is beyond its end.
+func (v *TabArray) SetTab(tabIndex int, alignment TabAlign, location int) {
+	C.pango_tab_array_set_tab(v.native(), C.gint(tabIndex),
+		C.PangoTabAlign(alignment), C.gint(location))
+}
+
+// GetTab is a wrapper around pango_tab_array_get_tab().
+func (v *TabArray) GetTab(tabIndex int) (alignment TabAlign, location int) {
+	var calignment C.PangoTabAlign
+	var clocation C.gint
+	C.pango_tab_array_get_tab(v.native(), C.gint(tabIndex), &calignment,
+		&clocation)
+	return TabAlign(calignment), int(clocation)
+}
+
+// GetPositionsInPixels is a wrapper around
+// pango_tab_array_get_positions_in_pixels().
+func (v *TabArray) GetPositionsInPixels() bool {
+	c := C.pango_tab_array_get_positions_in_pixels(v.native())
+	return gobool(c)
+}